@@ -0,0 +1,90 @@
+package multierr
+
+import (
+	"math/rand"
+)
+
+// OverflowPolicy decides which errors are kept once a bounded error reached its capacity.
+type OverflowPolicy int
+
+const (
+	// KeepFirst keeps the first errors and drops all new ones.
+	KeepFirst OverflowPolicy = iota
+	// KeepLast keeps the most recent errors by dropping the oldest ones (ring buffer).
+	KeepLast
+	// KeepSample keeps a uniformly distributed random sample of all errors (reservoir sampling).
+	// The order of the kept errors is not preserved.
+	KeepSample
+)
+
+// Bounded limits the number of sub-errors that are stored.
+// Once the capacity is reached, further errors are counted but dropped according to the given policy.
+// A capacity of zero or less removes the limit.
+// Formatters report the number of dropped errors as "... and n more errors (dropped)".
+//
+// If the error already contains more sub-errors than the capacity, the policy is applied immediately.
+//
+// If the error is not a multierr.Error, it will be converted.
+// Returns nil if the error is nil. Otherwise, the result is always an *Error.
+func Bounded(err error, capacity int, policy OverflowPolicy) error {
+	mErr := toError(err)
+	if mErr == nil {
+		return nil
+	}
+	mErr.capacity = capacity
+	mErr.overflow = policy
+	if capacity <= 0 || len(mErr.Errors) <= capacity {
+		return mErr
+	}
+
+	errs, stacks := mErr.Errors, mErr.stacks
+	mErr.Errors, mErr.stacks = make([]error, 0, capacity), nil
+	for i, e := range errs {
+		var stack []uintptr
+		if i < len(stacks) {
+			stack = stacks[i]
+		}
+		mErr.store(e, stack)
+	}
+	return mErr
+}
+
+// Dropped returns the number of errors that were dropped because the capacity was reached (see Bounded).
+func (e *Error) Dropped() int {
+	if e == nil {
+		return 0
+	}
+	return e.dropped
+}
+
+// store adds a single error and its stack trace, respecting the capacity.
+// Stack traces are only tracked if there are stack traces available.
+func (e *Error) store(err error, stack []uintptr) {
+	trackStacks := stack != nil || e.stacks != nil
+
+	if e.capacity <= 0 || len(e.Errors) < e.capacity {
+		e.Errors = append(e.Errors, err)
+		if trackStacks {
+			e.stacks = append(e.stacks, stack)
+		}
+		return
+	}
+
+	e.dropped++
+	switch e.overflow {
+	case KeepLast:
+		e.Errors = append(e.Errors[1:], err)
+		if trackStacks {
+			e.stacks = append(e.stacks[1:], stack)
+		}
+	case KeepSample:
+		idx := rand.Intn(len(e.Errors) + e.dropped)
+		if idx >= len(e.Errors) {
+			return
+		}
+		e.Errors[idx] = err
+		if trackStacks {
+			e.stacks[idx] = stack
+		}
+	}
+}
@@ -0,0 +1,115 @@
+package multierr
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func messages(errs []error) []string {
+	msgs := make([]string, len(errs))
+	for i, err := range errs {
+		msgs[i] = err.Error()
+	}
+	return msgs
+}
+
+func TestBounded_KeepFirst(t *testing.T) {
+	DefaultFormatter = ListFormatterFunc
+
+	err := Bounded(nil, 3, KeepFirst)
+	assert.Nil(t, err)
+
+	err = Bounded(&Error{}, 3, KeepFirst)
+	err = Merge(err, manyErrors(10))
+
+	assert.Equal(t, []string{"err 0", "err 1", "err 2"}, messages(Inspect(err)))
+	assert.Equal(t, 7, err.(*Error).Dropped())
+	assert.Equal(t, "10 errors occurred:\n"+
+		"  - err 0\n"+
+		"  - err 1\n"+
+		"  - err 2\n"+
+		"  - ... and 7 more errors (dropped)", err.Error())
+}
+
+func TestBounded_KeepLast(t *testing.T) {
+	DefaultFormatter = ListFormatterFunc
+
+	err := Bounded(&Error{}, 3, KeepLast)
+	err = Merge(err, manyErrors(1000))
+
+	assert.Equal(t, []string{"err 997", "err 998", "err 999"}, messages(Inspect(err)))
+	assert.Equal(t, 997, err.(*Error).Dropped())
+	assert.LessOrEqual(t, cap(err.(*Error).Errors), 8)
+	assert.Equal(t, "1000 errors occurred:\n"+
+		"  - ... and 997 more errors (dropped)\n"+
+		"  - err 997\n"+
+		"  - err 998\n"+
+		"  - err 999", err.Error())
+}
+
+func TestBounded_KeepSample(t *testing.T) {
+	err := Bounded(&Error{}, 10, KeepSample)
+	err = Merge(err, manyErrors(1000))
+
+	errs := Inspect(err)
+	assert.Len(t, errs, 10)
+	assert.Equal(t, 990, err.(*Error).Dropped())
+
+	// all kept errors are distinct
+	seen := make(map[string]bool)
+	for _, msg := range messages(errs) {
+		assert.False(t, seen[msg])
+		seen[msg] = true
+	}
+	// with very high probability, not all kept errors are the first ones
+	assert.NotEqual(t, []string{"err 0", "err 1", "err 2", "err 3", "err 4", "err 5", "err 6", "err 7", "err 8", "err 9"}, messages(errs))
+}
+
+func TestBounded_existingErrors(t *testing.T) {
+	err := manyErrors(5)
+	err = Bounded(err, 2, KeepLast)
+	assert.Equal(t, []string{"err 3", "err 4"}, messages(Inspect(err)))
+	assert.Equal(t, 3, err.(*Error).Dropped())
+
+	simpleErr := errors.New("err")
+	err = Bounded(simpleErr, 2, KeepFirst)
+	assert.Equal(t, []error{simpleErr}, Inspect(err))
+}
+
+func TestBounded_unlimited(t *testing.T) {
+	err := Bounded(manyErrors(5), 0, KeepFirst)
+	err = Merge(err, manyErrors(5))
+	assert.Len(t, Inspect(err), 10)
+	assert.Zero(t, err.(*Error).Dropped())
+
+	var typedNil *Error
+	assert.Zero(t, typedNil.Dropped())
+}
+
+func TestBounded_stacks(t *testing.T) {
+	withCaptureStacks(t)
+
+	err := Bounded(&Error{}, 2, KeepLast)
+	err = Append(err, errors.New("a"))
+	err.(*Error).Errors = append(err.(*Error).Errors, errors.New("b")) // no stack trace
+	err = Append(err, errors.New("c"))
+
+	assert.Equal(t, []string{"b", "c"}, messages(Inspect(err)))
+	assert.Nil(t, err.(*Error).StackTrace(0))
+	assert.NotNil(t, err.(*Error).StackTrace(1))
+}
+
+func TestBounded_truncated(t *testing.T) {
+	DefaultFormatter = ListFormatterFunc
+
+	err := Truncated(Bounded(&Error{}, 5, KeepFirst), 1, 1)
+	err = Merge(err, manyErrors(8))
+
+	assert.Equal(t, "8 errors occurred:\n"+
+		"  - err 0\n"+
+		"  - ... and 3 more errors\n"+
+		"  - err 4\n"+
+		"  - ... and 3 more errors (dropped)", err.Error())
+}
@@ -0,0 +1,68 @@
+package multierr
+
+import (
+	"sync"
+)
+
+// Collector accumulates errors from multiple go-routines.
+// All methods are safe for concurrent use.
+// The zero value is ready to use. A Collector must not be copied after first use.
+type Collector struct {
+	mu  sync.Mutex
+	err error
+}
+
+// Add appends all errors to the collector.
+// Any nil-error will be ignored.
+// This is the concurrency-safe equivalent of Append.
+func (c *Collector) Add(errs ...error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.err = combine(false, c.err, "", errs...)
+}
+
+// Merge appends all errors to the collector.
+// Any nil-error will be ignored. Multi-errors are flattened.
+// This is the concurrency-safe equivalent of Merge.
+func (c *Collector) Merge(errs ...error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.err = combine(true, c.err, "", errs...)
+}
+
+// MergePrefixed appends all errors to the collector.
+// Any nil-error will be ignored. Multi-errors are flattened.
+// This is the concurrency-safe equivalent of MergePrefixed.
+func (c *Collector) MergePrefixed(prefix string, errs ...error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.err = combine(true, c.err, prefix, errs...)
+}
+
+// Len returns the number of collected errors.
+func (c *Collector) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(Inspect(c.err))
+}
+
+// Err returns a snapshot of all collected errors, or nil if there are none.
+// A returned error will always be of type *Error.
+// Errors that are added after calling Err do not affect the returned snapshot.
+func (c *Collector) Err() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	mErr, ok := c.err.(*Error)
+	if !ok || mErr == nil || len(mErr.Errors) == 0 {
+		return nil
+	}
+	snapshot := *mErr
+	snapshot.Errors = make([]error, len(mErr.Errors))
+	copy(snapshot.Errors, mErr.Errors)
+	if mErr.stacks != nil {
+		snapshot.stacks = make([][]uintptr, len(mErr.stacks))
+		copy(snapshot.stacks, mErr.stacks)
+	}
+	return &snapshot
+}
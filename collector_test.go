@@ -0,0 +1,95 @@
+package multierr
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCollector_nothing(t *testing.T) {
+	var c Collector
+	assert.NoError(t, c.Err())
+	assert.Equal(t, 0, c.Len())
+
+	c.Add(nil)
+	c.Merge(nil, &Error{})
+	var typedNil *Error
+	c.MergePrefixed("prefix: ", typedNil)
+	assert.NoError(t, c.Err())
+	assert.Equal(t, 0, c.Len())
+}
+
+func TestCollector_Add(t *testing.T) {
+	var c Collector
+	err := errors.New("err")
+
+	c.Add(err, nil, err)
+	c.Add(Append(err, err))
+
+	result := c.Err()
+	assert.Len(t, result.(*Error).Errors, 3)
+	assert.Equal(t, 3, c.Len())
+}
+
+func TestCollector_Merge(t *testing.T) {
+	DefaultFormatter = ListFormatterFunc
+	var c Collector
+	err := errors.New("err")
+
+	c.Merge(err, Append(err, err))
+	c.MergePrefixed("prefix: ", Append(err, err))
+
+	assert.EqualError(t, c.Err(), "5 errors occurred:\n"+
+		"  - err\n"+
+		"  - err\n"+
+		"  - err\n"+
+		"  - prefix: err\n"+
+		"  - prefix: err")
+}
+
+func TestCollector_Err_snapshot(t *testing.T) {
+	var c Collector
+	c.Add(errors.New("err 1"))
+
+	snapshot := c.Err()
+	c.Add(errors.New("err 2"))
+
+	assert.Len(t, snapshot.(*Error).Errors, 1)
+	assert.Len(t, c.Err().(*Error).Errors, 2)
+
+	// modifying the snapshot does not affect the collector
+	_ = Append(snapshot, errors.New("err 3"))
+	assert.Len(t, c.Err().(*Error).Errors, 2)
+}
+
+func TestCollector_concurrent(t *testing.T) {
+	var c Collector
+	const routines = 50
+	const perRoutine = 20
+
+	var wg sync.WaitGroup
+	wg.Add(routines)
+	for r := 0; r < routines; r++ {
+		go func(r int) {
+			defer wg.Done()
+			for i := 0; i < perRoutine; i++ {
+				switch i % 3 {
+				case 0:
+					c.Add(fmt.Errorf("err %d/%d", r, i))
+				case 1:
+					c.Merge(fmt.Errorf("err %d/%d", r, i))
+				default:
+					c.MergePrefixed("prefix: ", fmt.Errorf("err %d/%d", r, i))
+				}
+				_ = c.Err()
+				_ = c.Len()
+			}
+		}(r)
+	}
+	wg.Wait()
+
+	assert.Len(t, Inspect(c.Err()), routines*perRoutine)
+}
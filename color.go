@@ -0,0 +1,124 @@
+package multierr
+
+import (
+	"io"
+	"os"
+	"reflect"
+	"strings"
+)
+
+// ANSI escape codes used by the color formatters.
+const (
+	ansiReset = "\x1b[0m"
+	ansiBold  = "\x1b[1m"
+	ansiDim   = "\x1b[2m"
+	ansiRed   = "\x1b[31m"
+)
+
+// lookupEnv is replaced in tests.
+var lookupEnv = os.LookupEnv
+
+// ColorEnabled reports whether colors should be used when writing to w.
+//
+// Colors are enabled if the FORCE_COLOR environment variable is set (and not "0" or "false").
+// Otherwise, colors are disabled if the NO_COLOR environment variable is set (see https://no-color.org).
+// Otherwise, colors are enabled if w is a terminal.
+// Writers are detected as terminal if they provide a "Stat() (os.FileInfo, error)" method
+// that reports a character device, like *os.File.
+func ColorEnabled(w io.Writer) bool {
+	if v, ok := lookupEnv("FORCE_COLOR"); ok && v != "" {
+		return v != "0" && v != "false"
+	}
+	if v, ok := lookupEnv("NO_COLOR"); ok && v != "" {
+		return false
+	}
+	f, ok := w.(interface{ Stat() (os.FileInfo, error) })
+	if !ok {
+		return false
+	}
+	info, err := f.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+// Colorized sets the error formatter to a colorized list formatter if colors are enabled for w (see ColorEnabled).
+// Only the default formatter and the ones set via Titled and Truncated are replaced.
+// Titles and truncation limits are kept.
+// Custom formatters, including the ones set via Prefixed or Deduplicated, are kept as well.
+// Otherwise, the error is returned unchanged.
+//
+// If the error is not a multierr.Error, it will be converted.
+// Returns nil if the error is nil. Otherwise, the result is always an *Error.
+//
+//	fmt.Fprintln(os.Stderr, multierr.Colorized(err, os.Stderr))
+func Colorized(err error, w io.Writer) error {
+	mErr := toError(err)
+	if mErr == nil {
+		return nil
+	}
+	if !ColorEnabled(w) || !mErr.hasStockFormatter() {
+		return mErr
+	}
+	if mErr.title != "" {
+		mErr.Formatter = ColorTitledListFormatter(mErr.title)
+	} else {
+		mErr.Formatter = ColorListFormatterFunc
+	}
+	if mErr.truncate != nil {
+		mErr.Formatter = TruncatedFormatter(mErr.Formatter, mErr.truncate.First, mErr.truncate.Last)
+	}
+	return mErr
+}
+
+// setStockFormatter sets a formatter that can be replaced by Colorized, as it only depends on the error's settings.
+// The formatter is wrapped into a dedicated closure, whose code pointer is recorded.
+// This allows detecting whether the formatter was replaced afterwards,
+// even if the new one is created the same way (like via TitledListFormatter).
+func (e *Error) setStockFormatter(formatter FormatterFunc) {
+	e.Formatter = func(errs []error) string {
+		return formatter(errs)
+	}
+	e.stock = reflect.ValueOf(e.Formatter).Pointer()
+}
+
+// hasStockFormatter reports whether the error uses the default formatter or the one set via setStockFormatter.
+func (e *Error) hasStockFormatter() bool {
+	return e.Formatter == nil || (e.stock != 0 && reflect.ValueOf(e.Formatter).Pointer() == e.stock)
+}
+
+// ColorListFormatterFunc works like ListFormatterFunc, but uses ANSI colors for terminal output.
+// The title is bold, bullets are red and the indentation of nested lines is dimmed.
+func ColorListFormatterFunc(errs []error) string {
+	if len(errs) == 0 {
+		return "no errors occurred"
+	}
+	return ColorTitledListFormatter(genericTitle(countErrors(errs)))(errs)
+}
+
+// ColorTitledListFormatter works like TitledListFormatter, but uses ANSI colors for terminal output.
+// The title is bold, bullets are red and the indentation of nested lines is dimmed.
+//
+// Nested multi-errors without custom formatter are colorized as well.
+func ColorTitledListFormatter(title string) FormatterFunc {
+	return func(errs []error) string {
+		if len(errs) == 0 {
+			return "no errors occurred"
+		}
+
+		var str = ansiBold + title + ansiReset
+		for _, err := range errs {
+			msg := strings.Replace(colorMessage(err), "\n", "\n"+ansiDim+"    "+ansiReset, -1)
+			str += "\n  " + ansiRed + "-" + ansiReset + " " + msg
+		}
+		return str
+	}
+}
+
+// colorMessage returns the error message.
+// Nested multi-errors that use the default formatter are colorized.
+func colorMessage(err error) string {
+	mErr, ok := err.(*Error)
+	if !ok || mErr == nil || mErr.Formatter != nil {
+		return err.Error()
+	}
+	return ColorListFormatterFunc(mErr.acyclic().visibleErrors())
+}
@@ -0,0 +1,155 @@
+package multierr
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeTerminal is a writer that reports the given file mode.
+type fakeTerminal struct {
+	bytes.Buffer
+	mode os.FileMode
+}
+
+func (f *fakeTerminal) Stat() (os.FileInfo, error) {
+	return fakeFileInfo{f.mode}, nil
+}
+
+type fakeFileInfo struct {
+	mode os.FileMode
+}
+
+func (i fakeFileInfo) Name() string       { return "fake" }
+func (i fakeFileInfo) Size() int64        { return 0 }
+func (i fakeFileInfo) Mode() os.FileMode  { return i.mode }
+func (i fakeFileInfo) ModTime() time.Time { return time.Time{} }
+func (i fakeFileInfo) IsDir() bool        { return false }
+func (i fakeFileInfo) Sys() interface{}   { return nil }
+
+// withEnv replaces the environment for the duration of the test.
+func withEnv(t *testing.T, env map[string]string) {
+	lookupEnv = func(key string) (string, bool) {
+		v, ok := env[key]
+		return v, ok
+	}
+	t.Cleanup(func() {
+		lookupEnv = os.LookupEnv
+	})
+}
+
+func TestColorEnabled(t *testing.T) {
+	terminal := &fakeTerminal{mode: os.ModeDevice | os.ModeCharDevice}
+	pipe := &fakeTerminal{mode: os.ModeNamedPipe}
+
+	withEnv(t, nil)
+	assert.True(t, ColorEnabled(terminal))
+	assert.False(t, ColorEnabled(pipe))
+	assert.False(t, ColorEnabled(&bytes.Buffer{}))
+
+	withEnv(t, map[string]string{"NO_COLOR": "1"})
+	assert.False(t, ColorEnabled(terminal))
+
+	withEnv(t, map[string]string{"NO_COLOR": ""})
+	assert.True(t, ColorEnabled(terminal))
+
+	withEnv(t, map[string]string{"FORCE_COLOR": "1", "NO_COLOR": "1"})
+	assert.True(t, ColorEnabled(pipe))
+	assert.True(t, ColorEnabled(&bytes.Buffer{}))
+
+	withEnv(t, map[string]string{"FORCE_COLOR": "0"})
+	assert.False(t, ColorEnabled(terminal))
+	withEnv(t, map[string]string{"FORCE_COLOR": "false"})
+	assert.False(t, ColorEnabled(terminal))
+}
+
+func TestColorListFormatterFunc(t *testing.T) {
+	assert.Equal(t, "no errors occurred", ColorListFormatterFunc(nil))
+
+	nested := Append(nil, errors.New("b"))
+	str := ColorListFormatterFunc([]error{
+		errors.New("a"),
+		nested,
+		errors.New("multi\nline"),
+	})
+	assert.Equal(t, "\x1b[1m3 errors occurred:\x1b[0m\n"+
+		"  \x1b[31m-\x1b[0m a\n"+
+		"  \x1b[31m-\x1b[0m \x1b[1m1 error occurred:\x1b[0m\n"+
+		"\x1b[2m    \x1b[0m  \x1b[31m-\x1b[0m b\n"+
+		"  \x1b[31m-\x1b[0m multi\n"+
+		"\x1b[2m    \x1b[0mline", str)
+}
+
+func TestColorTitledListFormatter(t *testing.T) {
+	nested := Titled(Append(nil, errors.New("b")), "nested:")
+	str := ColorTitledListFormatter("title:")([]error{errors.New("a"), nested})
+	assert.Equal(t, "\x1b[1mtitle:\x1b[0m\n"+
+		"  \x1b[31m-\x1b[0m a\n"+
+		"  \x1b[31m-\x1b[0m nested:\n"+
+		"\x1b[2m    \x1b[0m  - b", str)
+}
+
+func TestColorized(t *testing.T) {
+	DefaultFormatter = ListFormatterFunc
+	assert.Nil(t, Colorized(nil, os.Stderr))
+
+	withEnv(t, map[string]string{"NO_COLOR": "1"})
+	err := Colorized(errors.New("a"), &fakeTerminal{mode: os.ModeCharDevice})
+	assert.Equal(t, "1 error occurred:\n  - a", err.Error())
+
+	withEnv(t, nil)
+	var out fakeTerminal
+	out.mode = os.ModeCharDevice
+	fmt.Fprint(&out, Colorized(errors.New("a"), &out))
+	assert.Equal(t, "\x1b[1m1 error occurred:\x1b[0m\n  \x1b[31m-\x1b[0m a", out.String())
+
+	err = Colorized(Titled(errors.New("a"), "title:"), &out)
+	assert.Equal(t, "\x1b[1mtitle:\x1b[0m\n  \x1b[31m-\x1b[0m a", err.Error())
+	assert.Equal(t, "title:", err.(*Error).Title())
+
+	err = Colorized(Truncated(Titled(Append(errors.New("a"), errors.New("b")), "title:"), 1, 0), &out)
+	assert.Equal(t, "\x1b[1mtitle:\x1b[0m\n"+
+		"  \x1b[31m-\x1b[0m a\n"+
+		"  \x1b[31m-\x1b[0m ... and 1 more error", err.Error())
+
+	err = Colorized(Truncated(Append(errors.New("a"), errors.New("b")), 1, 0), &out)
+	assert.Equal(t, "\x1b[1m2 errors occurred:\x1b[0m\n"+
+		"  \x1b[31m-\x1b[0m a\n"+
+		"  \x1b[31m-\x1b[0m ... and 1 more error", err.Error())
+}
+
+func TestColorized_customFormatter(t *testing.T) {
+	DefaultFormatter = ListFormatterFunc
+	withEnv(t, map[string]string{"FORCE_COLOR": "1"})
+
+	a := errors.New("a")
+	err := Colorized(Deduplicated(Titled(Append(a, a, a), "t:")), &bytes.Buffer{})
+	assert.Equal(t, "t:\n  - a (x3)", err.Error())
+
+	err = Titled(errors.New("a"), "t:")
+	err.(*Error).Formatter = TitledListFormatter("custom:")
+	err = Colorized(err, &bytes.Buffer{})
+	assert.Equal(t, "custom:\n  - a", err.Error())
+
+	err = Titled(errors.New("a"), "t:")
+	err.(*Error).Formatter = SeverityFormatter(nil, nil)
+	err = Colorized(err, &bytes.Buffer{})
+	assert.Equal(t, "1 error occurred:\n  - [error] a", err.Error())
+
+	err = Prefixed(errors.New("a"), "prefix: ")
+	err = Colorized(err, &bytes.Buffer{})
+	assert.Equal(t, "prefix: a", err.Error())
+	assert.Equal(t, "prefix: ", err.(*Error).Prefix())
+
+	err = &Error{
+		Errors:    []error{errors.New("a")},
+		Formatter: CompactFormatter(0),
+	}
+	err = Colorized(err, &bytes.Buffer{})
+	assert.Equal(t, "1 error: a", err.Error())
+}
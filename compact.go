@@ -0,0 +1,124 @@
+package multierr
+
+import (
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// compactReplacer escapes separators and line breaks within compact messages.
+var compactReplacer = strings.NewReplacer(
+	`\`, `\\`,
+	";", `\;`,
+	"(", `\(`,
+	")", `\)`,
+	"\r\n", `\n`,
+	"\n", `\n`,
+	"\r", `\r`,
+)
+
+// compactLabelReplacer additionally escapes colons, which separate labels from their errors.
+var compactLabelReplacer = strings.NewReplacer(
+	`\`, `\\`,
+	";", `\;`,
+	"(", `\(`,
+	")", `\)`,
+	":", `\:`,
+	"\r\n", `\n`,
+	"\n", `\n`,
+	"\r", `\r`,
+)
+
+// CompactFormatter returns a formatter func that puts all errors into a single line,
+// like "3 errors: a; b; c (nested: d; e)".
+// This is useful for line-oriented log output.
+//
+// Nested multi-errors are labeled with their title (see Titled) or a generic "n errors",
+// followed by their sub-errors in parentheses.
+// Backslashes, separators (";", "(", ")") and line breaks within error messages are escaped with a backslash,
+// like "\;" and "\n", so that the output can be parsed unambiguously. Labels additionally escape ":".
+// If maxLen is positive, longer messages are cut and end with "...".
+func CompactFormatter(maxLen int) FormatterFunc {
+	return compactFormatter("", maxLen)
+}
+
+// Compact sets the error formatter to a CompactFormatter.
+// If the error has a title (see Titled), it is used instead of the generic "n errors".
+//
+// If the error is not a multierr.Error, it will be converted.
+// Returns nil if the error is nil. Otherwise, the result is always an *Error.
+func Compact(err error, maxLen int) error {
+	mErr := toError(err)
+	if mErr == nil {
+		return nil
+	}
+	mErr.Formatter = compactFormatter(mErr.title, maxLen)
+	return mErr
+}
+
+func compactFormatter(title string, maxLen int) FormatterFunc {
+	return func(errs []error) string {
+		if len(errs) == 0 {
+			return "no errors occurred"
+		}
+		var sb strings.Builder
+		sb.WriteString(compactLabel(title, countErrors(errs)) + ": ")
+		writeCompactList(&sb, errs)
+		return cut(sb.String(), maxLen)
+	}
+}
+
+// compactLabel returns the escaped title without trailing colon, like "invalid input",
+// or a generic "n errors" if there is no title.
+func compactLabel(title string, count int) string {
+	title = strings.TrimSuffix(strings.TrimSpace(title), ":")
+	if title != "" {
+		return compactLabelReplacer.Replace(title)
+	}
+	if count == 1 {
+		return "1 error"
+	}
+	return strconv.Itoa(count) + " errors"
+}
+
+func writeCompactList(sb *strings.Builder, errs []error) {
+	for i, err := range errs {
+		if i > 0 {
+			sb.WriteString("; ")
+		}
+		mErr, ok := err.(*Error)
+		if !ok || mErr == nil {
+			sb.WriteString(compactReplacer.Replace(err.Error()))
+			continue
+		}
+		sub := mErr.visibleErrors()
+		sb.WriteString(compactLabel(mErr.title, countErrors(sub)) + " (nested: ")
+		writeCompactList(sb, sub)
+		sb.WriteString(")")
+	}
+}
+
+// cut shortens the string to at most maxLen bytes, ending with "...".
+// Multi-byte characters are never split.
+func cut(s string, maxLen int) string {
+	const ellipsis = "..."
+	if maxLen <= 0 || len(s) <= maxLen {
+		return s
+	}
+	if maxLen <= len(ellipsis) {
+		return ellipsis[:maxLen]
+	}
+	end := maxLen - len(ellipsis)
+	for end > 0 && !utf8.RuneStart(s[end]) {
+		end--
+	}
+	// don't leave an incomplete escape sequence
+	backslashes := 0
+	for backslashes < end && s[end-1-backslashes] == '\\' {
+		backslashes++
+	}
+	if backslashes%2 == 1 {
+		end--
+	}
+	return s[:end] + ellipsis
+}
@@ -0,0 +1,71 @@
+package multierr
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompactFormatter(t *testing.T) {
+	formatter := CompactFormatter(0)
+	assert.Equal(t, "no errors occurred", formatter(nil))
+	assert.Equal(t, "1 error: a", formatter([]error{errors.New("a")}))
+
+	str := formatter([]error{
+		errors.New("a"),
+		errors.New("b"),
+		Titled(Append(nil, errors.New("d"), errors.New("e")), "c"),
+	})
+	assert.Equal(t, "3 errors: a; b; c (nested: d; e)", str)
+
+	str = formatter([]error{
+		errors.New("multi\nline\r\nmessage"),
+		Append(nil, errors.New("b"), Titled(Append(nil, errors.New("c")), "invalid input:")),
+	})
+	assert.Equal(t, `2 errors: multi\nline\nmessage; 2 errors (nested: b; invalid input (nested: c))`, str)
+}
+
+func TestCompactFormatter_escaping(t *testing.T) {
+	formatter := CompactFormatter(0)
+
+	str := formatter([]error{
+		errors.New(`a; b (c) \d`),
+		Titled(Append(nil, errors.New("e")), "f: (g);"),
+	})
+	assert.Equal(t, `2 errors: a\; b \(c\) \\d; f\: \(g\)\; (nested: e)`, str)
+
+	errs := []error{errors.New("a;bcdef")}
+	assert.Equal(t, `1 error: a...`, CompactFormatter(len(`1 error: a\`)+3)(errs), "must not split escape sequences")
+	assert.Equal(t, `1 error: a\;...`, CompactFormatter(len(`1 error: a\;`)+3)(errs))
+}
+
+func TestCompactFormatter_maxLen(t *testing.T) {
+	errs := []error{errors.New("a"), errors.New("äöü")}
+	assert.Equal(t, "2 errors: a; äöü", CompactFormatter(20)(errs))
+	assert.Equal(t, "2 errors: a; äöü", CompactFormatter(len("2 errors: a; äöü"))(errs))
+	assert.Equal(t, "2 errors: a; ä...", CompactFormatter(len("2 errors: a; ä")+3)(errs))
+	assert.Equal(t, "2 errors: a; ...", CompactFormatter(len("2 errors: a; ä")+2)(errs), "must not split characters")
+	assert.Equal(t, "..", CompactFormatter(2)(errs))
+}
+
+func TestCompactFormatter_truncated(t *testing.T) {
+	str := TruncatedFormatter(CompactFormatter(0), 1, 0)([]error{errors.New("a"), errors.New("b"), errors.New("c")})
+	assert.Equal(t, "3 errors: a; ... and 2 more errors", str)
+}
+
+func TestCompact(t *testing.T) {
+	assert.Nil(t, Compact(nil, 0))
+
+	err := Compact(errors.New("a"), 0)
+	assert.Equal(t, "1 error: a", err.Error())
+
+	err = Compact(Titled(Append(nil, errors.New("a"), errors.New("b")), "invalid\ninput:"), 0)
+	assert.Equal(t, `invalid\ninput: a; b`, err.Error())
+	assert.Equal(t, "invalid\ninput:", err.(*Error).Title())
+}
+
+func TestCompact_cycle(t *testing.T) {
+	a, _ := manualCycle()
+	assert.Equal(t, "3 errors: a1; EOF; 3 errors (nested: b1; <cycle>; <cycle>)", Compact(a, 0).Error())
+}
@@ -0,0 +1,197 @@
+package multierr
+
+// ErrCycle replaces sub-errors that would otherwise cause infinite recursion (see Acyclic).
+var ErrCycle error = cycleError{}
+
+// cycleError replaces errors that would otherwise cause infinite recursion.
+type cycleError struct{}
+
+// Error implements the error interface.
+func (cycleError) Error() string {
+	return "<cycle>"
+}
+
+// children returns the directly contained errors of multi-errors and wrapped errors.
+// Foreign multi-errors are recognized via Splitters.
+func children(err error) []error {
+	switch e := err.(type) {
+	case *Error:
+		if e == nil {
+			return nil
+		}
+		return e.Errors
+	case interface{ Unwrap() []error }:
+		return e.Unwrap()
+	case interface{ Unwrap() error }:
+		if unwrapped := e.Unwrap(); unwrapped != nil {
+			return []error{unwrapped}
+		}
+		return nil
+	}
+	errs, _ := split(err)
+	return errs
+}
+
+// reaches reports whether err is, or (recursively) contains, any of the target multi-errors.
+// Visited multi-errors are not walked again, so that shared sub-trees are only walked once
+// and existing cycles terminate.
+func reaches(err error, targets map[*Error]bool, visited map[*Error]bool) bool {
+	if mErr, ok := err.(*Error); ok && mErr != nil {
+		if targets[mErr] {
+			return true
+		}
+		if visited[mErr] {
+			return false
+		}
+		visited[mErr] = true
+	}
+	for _, child := range children(err) {
+		if reaches(child, targets, visited) {
+			return true
+		}
+	}
+	return false
+}
+
+// createsCycle reports whether adding err to the given multi-error would create a cycle.
+// visited can be shared between multiple calls for the same multi-error.
+func createsCycle(e *Error, err error, visited map[*Error]bool) bool {
+	if mErr, ok := err.(*Error); ok && mErr == e {
+		return true
+	}
+	if len(children(err)) == 0 {
+		return false
+	}
+	return reaches(err, map[*Error]bool{e: true}, visited)
+}
+
+// withoutCycles returns all errors that can be added to the given multi-error without creating a cycle.
+// The slice is only copied if errors need to be removed.
+func withoutCycles(e *Error, errs []error) []error {
+	visited := make(map[*Error]bool)
+	for i, err := range errs {
+		if !createsCycle(e, err, visited) {
+			continue
+		}
+		result := append([]error{}, errs[:i]...)
+		for _, err := range errs[i+1:] {
+			if !createsCycle(e, err, visited) {
+				result = append(result, err)
+			}
+		}
+		return result
+	}
+	return errs
+}
+
+// hasCycle reports whether err (recursively) contains itself or any of the active multi-errors.
+// Multi-errors in done are known to be free of cycles and are not walked again.
+func hasCycle(err error, active, done map[*Error]bool) bool {
+	mErr, ok := err.(*Error)
+	if ok && mErr != nil {
+		if active[mErr] {
+			return true
+		}
+		if done[mErr] {
+			return false
+		}
+		active[mErr] = true
+	}
+	for _, child := range children(err) {
+		if hasCycle(child, active, done) {
+			return true
+		}
+	}
+	if ok && mErr != nil {
+		delete(active, mErr)
+		done[mErr] = true
+	}
+	return false
+}
+
+// Acyclic returns a copy of the multi-error tree where all cyclic sub-errors are replaced by ErrCycle.
+// This allows walking the tree recursively without tracking visited multi-errors.
+// Only multi-errors are copied, and the copies must not be modified.
+//
+// If err is not a *Error, it is returned unchanged.
+func Acyclic(err error) error {
+	mErr, ok := err.(*Error)
+	if !ok || mErr == nil {
+		return err
+	}
+	return mErr.acyclic()
+}
+
+// acyclicErrors returns the sub-errors, where all cyclic errors are replaced by "<cycle>".
+// In contrast to acyclic, the sub-errors themselves are returned if there are no cycles,
+// so that they can still be compared by identity (errors.Is).
+func (e *Error) acyclicErrors() []error {
+	if e.checked || !hasCycle(e, make(map[*Error]bool), make(map[*Error]bool)) {
+		return e.Errors
+	}
+	return e.acyclic().Errors
+}
+
+// acyclic returns a copy of the error tree where all cyclic errors are replaced by "<cycle>".
+// Only multi-errors are copied. The copies are marked as checked,
+// so that formatting nested multi-errors does not walk the tree again.
+func (e *Error) acyclic() *Error {
+	if e == nil || e.checked {
+		return e
+	}
+	b := cycleBreaker{
+		active: make(map[*Error]bool),
+		copies: make(map[*Error]*Error),
+	}
+	return b.copy(e)
+}
+
+// cycleBreaker copies multi-errors and replaces errors that reach back into one of their ancestors.
+type cycleBreaker struct {
+	active map[*Error]bool   // multi-errors that are currently being copied (ancestors)
+	copies map[*Error]*Error // multi-errors that were already copied
+}
+
+// breakCycles returns the error or its replacement, and whether it was replaced.
+func (b *cycleBreaker) breakCycles(err error) (error, bool) {
+	mErr, ok := err.(*Error)
+	if !ok || mErr == nil {
+		if len(children(err)) > 0 && reaches(err, b.active, make(map[*Error]bool)) {
+			return ErrCycle, true
+		}
+		return err, false
+	}
+	if b.active[mErr] {
+		return ErrCycle, true
+	}
+	c := b.copy(mErr)
+	return c, c != mErr
+}
+
+func (b *cycleBreaker) copy(mErr *Error) *Error {
+	if c, ok := b.copies[mErr]; ok {
+		return c
+	}
+	if mErr.checked {
+		return mErr
+	}
+	b.active[mErr] = true
+	defer delete(b.active, mErr)
+
+	c := *mErr
+	c.checked = true
+	copied := false
+	for i, sub := range mErr.Errors {
+		replaced, ok := b.breakCycles(sub)
+		if !ok {
+			continue
+		}
+		if !copied { // the original slice must not be modified
+			c.Errors = append([]error{}, mErr.Errors...)
+			copied = true
+		}
+		c.Errors[i] = replaced
+	}
+	b.copies[mErr] = &c
+	return &c
+}
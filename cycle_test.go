@@ -0,0 +1,155 @@
+package multierr
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAppend_rejectsCycles(t *testing.T) {
+	a, b := errors.New("a"), errors.New("b")
+
+	mErr := Append(a, b)
+	result := Append(mErr, mErr)
+	assert.Same(t, mErr, result)
+	assert.Equal(t, []error{a, b}, Inspect(result))
+
+	// indirect cycles
+	outer := Append(nil, mErr)
+	result = Append(mErr, outer, fmt.Errorf("wrapped: %w", mErr), &FieldError{Err: outer})
+	assert.Equal(t, []error{a, b}, Inspect(result))
+
+	// cycles through foreign multi-errors
+	result = Append(mErr, &joinErr{[]error{a, mErr}})
+	assert.Equal(t, []error{a, b}, Inspect(result))
+
+	// sharing errors without cycles is fine
+	other := Append(nil, outer, outer)
+	assert.Len(t, Inspect(other), 2)
+}
+
+func TestMerge_rejectsCycles(t *testing.T) {
+	a, b := errors.New("a"), errors.New("b")
+
+	// merging an error into itself duplicates the sub-errors
+	mErr := Append(a, b)
+	result := Merge(mErr, mErr)
+	assert.Equal(t, []error{a, b, a, b}, Inspect(result))
+
+	mErr = Append(a, b)
+	outer := Append(errors.New("c"), mErr)
+	result = Merge(mErr, outer)
+	assert.Equal(t, []string{"a", "b", "c"}, messages(Inspect(result)))
+
+	mErr = Append(a, b)
+	outer = Append(errors.New("c"), mErr)
+	result = MergePrefixed(mErr, "prefix: ", outer)
+	assert.Equal(t, []string{"a", "b", "prefix: c"}, messages(Inspect(result)))
+}
+
+// manualCycle creates a cycle a -> b -> a, bypassing the checks of Append.
+func manualCycle() (*Error, *Error) {
+	a := &Error{Errors: []error{errors.New("a1"), io.EOF}}
+	b := &Error{Errors: []error{errors.New("b1")}}
+	a.Errors = append(a.Errors, b)
+	b.Errors = append(b.Errors, a, &FieldError{Path: FieldPath{{Name: "field"}}, Err: b})
+	return a, b
+}
+
+func TestError_Error_cycle(t *testing.T) {
+	DefaultFormatter = ListFormatterFunc
+
+	a, _ := manualCycle()
+	assert.Equal(t, "3 errors occurred:\n"+
+		"  - a1\n"+
+		"  - EOF\n"+
+		"  - 3 errors occurred:\n"+
+		"      - b1\n"+
+		"      - <cycle>\n"+
+		"      - <cycle>", a.Error())
+
+	self := &Error{}
+	self.Errors = []error{errors.New("x"), self}
+	assert.Equal(t, "2 errors occurred:\n  - x\n  - <cycle>", self.Error())
+
+	self.Formatter = TreeFormatter(TreeOptions{})
+	assert.Equal(t, "2 errors occurred:\n├─ x\n└─ <cycle>", self.Error())
+
+	// the original errors are not modified
+	assert.Same(t, self, self.Errors[1])
+}
+
+func TestError_Format_cycle(t *testing.T) {
+	DefaultFormatter = ListFormatterFunc
+	a, _ := manualCycle()
+
+	assert.Contains(t, fmt.Sprintf("%+v", a), "\n[2.1] <cycle>")
+	assert.Contains(t, fmt.Sprintf("%#v", a), "multierr.cycleError{}")
+}
+
+func TestError_Unwrap_cycle(t *testing.T) {
+	a, b := manualCycle()
+
+	assert.True(t, errors.Is(a, io.EOF))
+	assert.True(t, errors.Is(b, io.EOF))
+	assert.False(t, errors.Is(a, io.ErrUnexpectedEOF))
+	assert.False(t, errors.Is(b, io.ErrUnexpectedEOF))
+
+	// the field error references its parent and is therefore replaced
+	var fieldErr *FieldError
+	assert.False(t, errors.As(a, &fieldErr))
+}
+
+func TestError_MarshalJSON_cycle(t *testing.T) {
+	a, _ := manualCycle()
+	data, err := json.Marshal(a)
+	assert.NoError(t, err)
+	assert.Contains(t, string(data), `{"message":"\u003ccycle\u003e"}`)
+}
+
+func TestAcyclic(t *testing.T) {
+	assert.Nil(t, Acyclic(nil))
+	assert.Same(t, io.EOF, Acyclic(io.EOF))
+
+	a, b := manualCycle()
+	acyclic := Acyclic(a).(*Error)
+	assert.Equal(t, a.Errors[:2], acyclic.Errors[:2])
+	nested := acyclic.Errors[2].(*Error)
+	assert.Equal(t, b.Errors[0], nested.Errors[0])
+	assert.Equal(t, ErrCycle, nested.Errors[1])
+	assert.Equal(t, ErrCycle, nested.Errors[2])
+
+	// the original is not modified
+	assert.Same(t, a, b.Errors[1])
+}
+
+func TestCycles_sharedSubtrees(t *testing.T) {
+	// every level contains the previous one twice, resulting in 2^64 paths
+	shared := &Error{Errors: []error{io.EOF}}
+	for i := 0; i < 64; i++ {
+		wrapped := &FieldError{Path: FieldPath{{Name: "field"}}, Err: shared}
+		shared = &Error{Errors: []error{shared, wrapped}}
+	}
+
+	err := Append(&Error{}, shared)
+	assert.Len(t, Inspect(err), 1)
+	err = Merge(&Error{}, shared)
+	assert.Len(t, Inspect(err), 2)
+	assert.Len(t, shared.Unwrap(), 2)
+
+	acyclic := shared.acyclic()
+	assert.True(t, acyclic.checked)
+	assert.Same(t, acyclic, acyclic.acyclic())
+	nested := acyclic.Errors[0].(*Error)
+	assert.True(t, nested.checked)
+
+	// a cycle next to shared sub-trees
+	a, _ := manualCycle()
+	a.Errors = append(a.Errors, shared)
+	assert.Len(t, a.acyclic().Errors, 4)
+	assert.Len(t, Inspect(Append(&Error{}, a)), 1)
+}
@@ -0,0 +1,99 @@
+package multierr
+
+import (
+	"fmt"
+)
+
+// KeyFunc returns the key that is used to detect duplicate errors.
+type KeyFunc func(err error) string
+
+// MessageKey uses the error message to detect duplicate errors.
+func MessageKey(err error) string {
+	return err.Error()
+}
+
+// DeduplicatedFormatter returns a formatter func that groups sub-errors with identical keys.
+// Only the first error of each group is passed to the given formatter, in order of first occurrence.
+// Errors that occurred multiple times are annotated with their count, like "connection refused (x500)".
+//
+// If formatter is nil, the current DefaultFormatter is used.
+// This allows using the result as DefaultFormatter.
+// If key is nil, errors are grouped by their message (see MessageKey).
+func DeduplicatedFormatter(formatter FormatterFunc, key KeyFunc) FormatterFunc {
+	formatter = resolveFormatter(formatter)
+	if key == nil {
+		key = MessageKey
+	}
+	return func(errs []error) string {
+		return formatter(deduplicate(errs, key))
+	}
+}
+
+// Deduplicated wraps the error's formatter with a DeduplicatedFormatter that groups errors by message.
+// Titles and prefixes are kept.
+//
+// If the error is not a multierr.Error, it will be converted.
+// Returns nil if the error is nil. Otherwise, the result is always an *Error.
+func Deduplicated(err error) error {
+	mErr := toError(err)
+	if mErr == nil {
+		return nil
+	}
+	mErr.Formatter = DeduplicatedFormatter(mErr.Formatter, nil)
+	return mErr
+}
+
+// deduplicate groups errors by their key.
+// Truncated errors are restored beforehand, so that all occurrences are counted.
+// The result is truncated again afterwards.
+func deduplicate(errs []error, key KeyFunc) []error {
+	errs, limits := untruncate(errs)
+	groups := make(map[string]int, len(errs)) // key -> index in result
+	counts := make([]int, 0, len(errs))
+	result := make([]error, 0, len(errs))
+
+	for _, err := range errs {
+		if _, ok := err.(*omittedErrors); ok {
+			result = append(result, err)
+			counts = append(counts, 1)
+			continue
+		}
+		k := key(err)
+		if idx, ok := groups[k]; ok {
+			counts[idx]++
+			continue
+		}
+		groups[k] = len(result)
+		result = append(result, err)
+		counts = append(counts, 1)
+	}
+
+	for i, count := range counts {
+		if count > 1 {
+			result[i] = &repeatedError{
+				err:   result[i],
+				count: count,
+			}
+		}
+	}
+	if limits != nil {
+		return limits.apply(result)
+	}
+	return result
+}
+
+// repeatedError is passed to formatters as placeholder for an error that occurred multiple times.
+type repeatedError struct {
+	err   error
+	count int
+}
+
+// Error implements the error interface.
+func (e *repeatedError) Error() string {
+	return fmt.Sprintf("%s (x%d)", e.err.Error(), e.count)
+}
+
+// Unwrap returns the first occurrence of the repeated error.
+func (e *repeatedError) Unwrap() error {
+	return e.err
+}
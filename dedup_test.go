@@ -0,0 +1,122 @@
+package multierr
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeduplicatedFormatter(t *testing.T) {
+	DefaultFormatter = ListFormatterFunc
+
+	var err error
+	for i := 0; i < 500; i++ {
+		err = Append(err, errors.New("connection refused"))
+		if i%100 == 0 {
+			err = Append(err, errors.New("timeout"))
+		}
+	}
+	err = Append(err, errors.New("unique"))
+	err.(*Error).Formatter = DeduplicatedFormatter(nil, nil)
+
+	assert.Equal(t, "506 errors occurred:\n"+
+		"  - connection refused (x500)\n"+
+		"  - timeout (x5)\n"+
+		"  - unique", err.Error())
+	assert.Len(t, Inspect(err), 506)
+}
+
+func TestDeduplicatedFormatter_composition(t *testing.T) {
+	err := Append(errors.New("a"), errors.New("b"), errors.New("a"))
+
+	err.(*Error).Formatter = DeduplicatedFormatter(TitledListFormatter("title"), nil)
+	assert.Equal(t, "title\n"+
+		"  - a (x2)\n"+
+		"  - b", err.Error())
+
+	err.(*Error).Formatter = DeduplicatedFormatter(PrefixedListFormatter("prefix: "), nil)
+	assert.Equal(t, "prefix: a (x2)\n"+
+		"prefix: b", err.Error())
+}
+
+func TestDeduplicatedFormatter_keyFunc(t *testing.T) {
+	err := Append(
+		errors.New("row 1: connection refused"),
+		errors.New("row 2: timeout"),
+		errors.New("row 3: connection refused"),
+	)
+	err.(*Error).Formatter = DeduplicatedFormatter(TitledListFormatter("title"), func(err error) string {
+		return strings.SplitN(err.Error(), ": ", 2)[1]
+	})
+
+	assert.Equal(t, "title\n"+
+		"  - row 1: connection refused (x2)\n"+
+		"  - row 2: timeout", err.Error())
+}
+
+func TestDeduplicatedFormatter_default(t *testing.T) {
+	DefaultFormatter = ListFormatterFunc
+	DefaultFormatter = DeduplicatedFormatter(nil, nil)
+	defer func() {
+		DefaultFormatter = ListFormatterFunc
+	}()
+
+	err := Append(errors.New("a"), errors.New("a"))
+	assert.Equal(t, "2 errors occurred:\n  - a (x2)", err.Error())
+}
+
+func TestDeduplicated(t *testing.T) {
+	DefaultFormatter = ListFormatterFunc
+	assert.Nil(t, Deduplicated(nil))
+
+	err := Titled(Append(errors.New("a"), errors.New("a")), "title")
+	err = Deduplicated(err)
+	assert.Equal(t, "title\n  - a (x2)", err.Error())
+	assert.Equal(t, "title", err.(*Error).Title())
+
+	err = Deduplicated(Append(errors.New("a"), errors.New("a")))
+	assert.Equal(t, "2 errors occurred:\n  - a (x2)", err.Error())
+}
+
+func TestDeduplicated_truncated(t *testing.T) {
+	DefaultFormatter = ListFormatterFunc
+
+	var err error
+	for i := 0; i < 10; i++ {
+		err = Append(err, errors.New("a"), fmt.Errorf("b%d", i))
+	}
+	err = Deduplicated(Truncated(err, 4, 0))
+
+	assert.Equal(t, "20 errors occurred:\n"+
+		"  - a (x10)\n"+
+		"  - b0\n"+
+		"  - b1\n"+
+		"  - b2\n"+
+		"  - ... and 7 more errors", err.Error())
+}
+
+func TestDeduplicated_truncatedRepeated(t *testing.T) {
+	DefaultFormatter = ListFormatterFunc
+
+	err := Append(errors.New("a"), errors.New("b"), errors.New("a"), errors.New("a"))
+	err = Deduplicated(Truncated(err, 2, 0))
+	assert.Equal(t, "4 errors occurred:\n"+
+		"  - a (x3)\n"+
+		"  - b", err.Error())
+
+	err = Append(err, errors.New("c"), errors.New("c"), errors.New("d"))
+	assert.Equal(t, "7 errors occurred:\n"+
+		"  - a (x3)\n"+
+		"  - b\n"+
+		"  - ... and 3 more errors", err.Error())
+}
+
+func TestRepeatedError_Unwrap(t *testing.T) {
+	inner := errors.New("a")
+	deduplicated := deduplicate([]error{inner, inner}, MessageKey)
+	assert.Len(t, deduplicated, 1)
+	assert.True(t, errors.Is(deduplicated[0], inner))
+}
@@ -0,0 +1,214 @@
+package multierr
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// PathElement is a single element of a FieldPath.
+// It either references a named field or, if Name is empty, a slice index.
+// Empty field names are therefore not supported.
+type PathElement struct {
+	Name  string
+	Index int
+}
+
+// FieldPath is the structured path to a (nested) field, like "address.street" or "items[3].name".
+type FieldPath []PathElement
+
+// fieldNameReplacer escapes characters that have a special meaning within field paths.
+var fieldNameReplacer = strings.NewReplacer(`\`, `\\`, ".", `\.`, "[", `\[`, "]", `\]`)
+
+// String returns the textual representation of the path, like "items[3].name".
+// Backslashes, dots and brackets within field names are escaped with a backslash, like "labels.app\.kubernetes\.io".
+func (p FieldPath) String() string {
+	var sb strings.Builder
+	for i, elem := range p {
+		if elem.Name == "" {
+			sb.WriteString("[" + strconv.Itoa(elem.Index) + "]")
+			continue
+		}
+		if i > 0 {
+			sb.WriteByte('.')
+		}
+		sb.WriteString(fieldNameReplacer.Replace(elem.Name))
+	}
+	return sb.String()
+}
+
+// ParseFieldPath parses the textual representation of a path, like "items[3].name".
+// Escaped characters within field names are unescaped (see FieldPath.String).
+func ParseFieldPath(path string) (FieldPath, error) {
+	var result FieldPath
+	rest := path
+	for rest != "" {
+		if rest[0] == '[' {
+			end := strings.IndexByte(rest, ']')
+			if end < 0 {
+				return nil, fmt.Errorf("invalid field path %q: missing closing bracket", path)
+			}
+			idx, err := strconv.Atoi(rest[1:end])
+			if err != nil || idx < 0 {
+				return nil, fmt.Errorf("invalid field path %q: invalid index %q", path, rest[1:end])
+			}
+			result = append(result, PathElement{Index: idx})
+			rest = rest[end+1:]
+			continue
+		}
+
+		if len(result) > 0 {
+			if rest[0] != '.' {
+				return nil, fmt.Errorf("invalid field path %q: missing separator", path)
+			}
+			rest = rest[1:]
+		}
+		var name strings.Builder
+		end := 0
+		for end < len(rest) && rest[end] != '.' && rest[end] != '[' {
+			if rest[end] == '\\' {
+				end++
+				if end == len(rest) {
+					return nil, fmt.Errorf("invalid field path %q: incomplete escape sequence", path)
+				}
+			}
+			name.WriteByte(rest[end])
+			end++
+		}
+		if end == 0 {
+			return nil, fmt.Errorf("invalid field path %q: empty field name", path)
+		}
+		result = append(result, PathElement{Name: name.String()})
+		rest = rest[end:]
+	}
+	return result, nil
+}
+
+// FieldError is an error that belongs to a specific (nested) field.
+type FieldError struct {
+	Path FieldPath
+	Err  error
+}
+
+// Error implements the error interface.
+// The error message is prefixed with the field path, like "address.street: missing".
+func (e *FieldError) Error() string {
+	if len(e.Path) == 0 {
+		return e.Err.Error()
+	}
+	return e.Path.String() + ": " + e.Err.Error()
+}
+
+// Unwrap returns the underlying error.
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// MergeField combines all errors into a single multi-error.
+// Any nil-error will be ignored. Returns nil if there are no errors.
+// A returned error will always be of type *Error.
+//
+// Works like MergePrefixed, but every merged error is converted into a *FieldError
+// that belongs to the given field.
+// If a merged error already is a *FieldError, the field is prepended to its path.
+// This allows composing paths when validating nested structures.
+// If field is empty, the errors are merged without adding a path element.
+func MergeField(err error, field string, errs ...error) error {
+	if field == "" {
+		return combine(true, err, "", errs...)
+	}
+	return combine(false, err, "", fieldErrors(PathElement{Name: field}, errs)...)
+}
+
+// MergeIndex combines all errors into a single multi-error.
+// Works like MergeField, but for elements of a slice with the given index.
+func MergeIndex(err error, index int, errs ...error) error {
+	return combine(false, err, "", fieldErrors(PathElement{Index: index}, errs)...)
+}
+
+// fieldErrors flattens all errors and converts them into field errors.
+func fieldErrors(elem PathElement, errs []error) []error {
+	var fieldErrs []error
+	for _, e := range errs {
+		for _, sub := range Inspect(e) {
+			fieldErrs = append(fieldErrs, withPathElement(sub, elem))
+		}
+	}
+	return fieldErrs
+}
+
+func withPathElement(err error, elem PathElement) *FieldError {
+	fieldErr, ok := err.(*FieldError)
+	if !ok {
+		return &FieldError{
+			Path: FieldPath{elem},
+			Err:  err,
+		}
+	}
+	path := make(FieldPath, 0, len(fieldErr.Path)+1)
+	path = append(path, elem)
+	path = append(path, fieldErr.Path...)
+	return &FieldError{
+		Path: path,
+		Err:  fieldErr.Err,
+	}
+}
+
+// AsFieldError returns the *FieldError at the end of a chain of wrapped errors (like fmt.Errorf("%w")).
+// In contrast to errors.As, multi-errors are not walked into, as their other sub-errors would be ignored.
+func AsFieldError(err error) (*FieldError, bool) {
+	for ; err != nil; err = errors.Unwrap(err) {
+		switch e := err.(type) {
+		case *FieldError:
+			return e, true
+		case *Error:
+			return nil, false // unwraps into a chain of all sub-errors before Go 1.20
+		}
+	}
+	return nil, false
+}
+
+// FieldErrors returns all (nested) sub-errors that are (or wrap) a *FieldError.
+func FieldErrors(err error) []*FieldError {
+	var result []*FieldError
+	walkLeaves(err, func(e error) {
+		if fieldErr, ok := AsFieldError(e); ok {
+			result = append(result, fieldErr)
+		}
+	})
+	return result
+}
+
+// GroupByField groups all (nested) sub-errors by their field path, like "items[3].name".
+// Sub-errors that are (or wrap) a *FieldError are stored without their path.
+// All other errors are stored with an empty path.
+func GroupByField(err error) map[string][]error {
+	if err == nil {
+		return nil
+	}
+	result := make(map[string][]error)
+	walkLeaves(err, func(e error) {
+		if fieldErr, ok := AsFieldError(e); ok {
+			path := fieldErr.Path.String()
+			result[path] = append(result[path], fieldErr.Err)
+		} else {
+			result[""] = append(result[""], e)
+		}
+	})
+	if len(result) == 0 {
+		return nil
+	}
+	return result
+}
+
+// walkLeaves calls fn for all (nested) sub-errors that are not multi-errors of this package.
+// If err is not a multi-error, fn is called for err itself.
+func walkLeaves(err error, fn func(error)) {
+	Walk(err, func(e error, _ int, _ []int) bool {
+		if _, ok := e.(*Error); !ok {
+			fn(e)
+		}
+		return true
+	})
+}
@@ -0,0 +1,175 @@
+package multierr
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFieldPath_String(t *testing.T) {
+	assert.Equal(t, "", FieldPath{}.String())
+	assert.Equal(t, "name", FieldPath{{Name: "name"}}.String())
+	assert.Equal(t, "address.street", FieldPath{{Name: "address"}, {Name: "street"}}.String())
+	assert.Equal(t, "items[3].name", FieldPath{{Name: "items"}, {Index: 3}, {Name: "name"}}.String())
+	assert.Equal(t, "[0][1]", FieldPath{{Index: 0}, {Index: 1}}.String())
+	assert.Equal(t, `a\.b.c\[1\]\\`, FieldPath{{Name: "a.b"}, {Name: `c[1]\`}}.String())
+}
+
+func TestParseFieldPath(t *testing.T) {
+	for _, path := range []string{"", "name", "address.street", "items[3].name", "[0][1]", "a[1].b[2].c"} {
+		parsed, err := ParseFieldPath(path)
+		assert.NoError(t, err)
+		assert.Equal(t, path, parsed.String())
+	}
+
+	parsed, err := ParseFieldPath("items[3].name")
+	assert.NoError(t, err)
+	assert.Equal(t, FieldPath{{Name: "items"}, {Index: 3}, {Name: "name"}}, parsed)
+
+	parsed, err = ParseFieldPath(`labels.app\.io[0].a\[b\]\\`)
+	assert.NoError(t, err)
+	assert.Equal(t, FieldPath{{Name: "labels"}, {Name: "app.io"}, {Index: 0}, {Name: `a[b]\`}}, parsed)
+
+	for _, path := range []string{"a..b", ".a", "a.", "a[", "a[x]", "a[-1]", "a[1]b", `a\`} {
+		_, err := ParseFieldPath(path)
+		assert.Error(t, err, path)
+	}
+}
+
+func TestFieldError(t *testing.T) {
+	inner := errors.New("missing")
+	err := &FieldError{
+		Path: FieldPath{{Name: "address"}, {Name: "street"}},
+		Err:  inner,
+	}
+	assert.EqualError(t, err, "address.street: missing")
+	assert.True(t, errors.Is(err, inner))
+
+	err = &FieldError{Err: inner}
+	assert.EqualError(t, err, "missing")
+}
+
+func TestMergeField(t *testing.T) {
+	DefaultFormatter = ListFormatterFunc
+
+	var itemErr error
+	itemErr = MergeField(itemErr, "name", errors.New("missing"))
+
+	var addrErr error
+	addrErr = MergeField(addrErr, "street", errors.New("missing"))
+	addrErr = MergeField(addrErr, "city", errors.New("too long"), errors.New("invalid characters"))
+
+	var valErr error
+	valErr = Append(valErr, errors.New("general error"))
+	valErr = MergeField(valErr, "address", addrErr)
+	valErr = MergeField(valErr, "items", MergeIndex(nil, 3, itemErr))
+	valErr = MergeField(valErr, "nothing", nil, &Error{})
+
+	assert.EqualError(t, valErr, "5 errors occurred:\n"+
+		"  - general error\n"+
+		"  - address.street: missing\n"+
+		"  - address.city: too long\n"+
+		"  - address.city: invalid characters\n"+
+		"  - items[3].name: missing")
+
+	// original errors are not modified
+	assert.Equal(t, "name", itemErr.(*Error).Errors[0].(*FieldError).Path.String())
+}
+
+func TestMergeField_emptyName(t *testing.T) {
+	x := errors.New("x")
+	err := MergeField(nil, "", x)
+	assert.Equal(t, []error{x}, Inspect(err))
+	assert.Equal(t, "x", GroupByField(err)[""][0].Error())
+}
+
+func TestMergeField_nothing(t *testing.T) {
+	var typedNil *Error
+	assert.NoError(t, MergeField(nil, "field", nil, &Error{}, typedNil))
+	assert.NoError(t, MergeIndex(nil, 1))
+}
+
+func TestFieldErrors(t *testing.T) {
+	err := MergeField(errors.New("general"), "a", errors.New("x"))
+	err = Append(err, fmt.Errorf("wrapped: %w", &FieldError{Path: FieldPath{{Name: "b"}}, Err: errors.New("y")}))
+
+	fieldErrs := FieldErrors(err)
+	assert.Len(t, fieldErrs, 2)
+	assert.Equal(t, "a", fieldErrs[0].Path.String())
+	assert.Equal(t, "b", fieldErrs[1].Path.String())
+
+	assert.Nil(t, FieldErrors(nil))
+}
+
+func TestGroupByField(t *testing.T) {
+	x, y, z, general := errors.New("x"), errors.New("y"), errors.New("z"), errors.New("general")
+
+	err := MergeField(general, "a", x, y)
+	err = MergeField(err, "items", MergeIndex(nil, 2, z))
+
+	assert.Equal(t, map[string][]error{
+		"":         {general},
+		"a":        {x, y},
+		"items[2]": {z},
+	}, GroupByField(err))
+
+	assert.Nil(t, GroupByField(nil))
+}
+
+func TestGroupByField_nested(t *testing.T) {
+	x, missing, plain, negative := errors.New("x"), errors.New("missing"), errors.New("plain"), errors.New("negative")
+
+	nested := MergeField(nil, "name", missing)
+	nested = Append(nested, plain)
+	nested = MergeField(nested, "age", negative)
+	err := Append(x, nested)
+
+	assert.Equal(t, map[string][]error{
+		"":     {x, plain},
+		"name": {missing},
+		"age":  {negative},
+	}, GroupByField(err))
+	assert.Len(t, FieldErrors(err), 2)
+
+	// wrapped multi-errors are not searched for field errors
+	_, ok := AsFieldError(fmt.Errorf("wrapped: %w", nested))
+	assert.False(t, ok)
+}
+
+func TestFieldError_JSON(t *testing.T) {
+	DefaultFormatter = ListFormatterFunc
+
+	err := MergeField(nil, "items", MergeIndex(nil, 3, MergeField(nil, "name", errors.New("missing"))))
+
+	data, jsonErr := json.Marshal(err)
+	assert.NoError(t, jsonErr)
+	assert.JSONEq(t, `{
+		"message": "1 error occurred:\n  - items[3].name: missing",
+		"errors": [
+			{"message": "missing", "field": "items[3].name"}
+		]
+	}`, string(data))
+
+	var restored Error
+	assert.NoError(t, json.Unmarshal(data, &restored))
+	assert.Equal(t, err.Error(), restored.Error())
+	assert.Equal(t, FieldPath{{Name: "items"}, {Index: 3}, {Name: "name"}}, restored.Errors[0].(*FieldError).Path)
+
+	assert.Error(t, json.Unmarshal([]byte(`{"message": "", "errors": [{"message": "x", "field": "a..b"}]}`), &restored))
+}
+
+func TestFieldError_JSON_specialNames(t *testing.T) {
+	err := MergeField(nil, "weird[key", errors.New("x"))
+	err = MergeField(err, "a.b", errors.New("y"))
+
+	data, jsonErr := json.Marshal(err)
+	assert.NoError(t, jsonErr)
+
+	var restored Error
+	assert.NoError(t, json.Unmarshal(data, &restored))
+	assert.Equal(t, FieldPath{{Name: "weird[key"}}, restored.Errors[0].(*FieldError).Path)
+	assert.Equal(t, FieldPath{{Name: "a.b"}}, restored.Errors[1].(*FieldError).Path)
+}
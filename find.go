@@ -0,0 +1,85 @@
+package multierr
+
+import (
+	"reflect"
+)
+
+// visit calls fn for err and all (recursively) contained errors, depth-first.
+// In contrast to Inspect, it also walks wrapped errors (like fmt.Errorf("%w")) and foreign multi-errors.
+// The walk stops as soon as fn returns false. Cycles are skipped.
+// Returns false if the walk was stopped.
+func visit(err error, path []*Error, fn func(error) bool) bool {
+	if err == nil {
+		return true
+	}
+	if mErr, ok := err.(*Error); ok && mErr != nil {
+		for _, p := range path {
+			if p == mErr {
+				return true
+			}
+		}
+		path = append(path, mErr)
+	}
+	if !fn(err) {
+		return false
+	}
+	for _, child := range children(err) {
+		if !visit(child, path, fn) {
+			return false
+		}
+	}
+	return true
+}
+
+// matches reports whether err matches the target, like errors.Is does for a single error in the chain.
+func matches(err, target error) bool {
+	if reflect.TypeOf(target).Comparable() && err == target {
+		return true
+	}
+	if x, ok := err.(interface{ Is(error) bool }); ok && x.Is(target) {
+		return true
+	}
+	return false
+}
+
+// IsAny reports whether any error in err's tree matches any of the targets.
+// The tree is walked like errors.Is does, but also includes foreign multi-errors recognized by Splitters.
+func IsAny(err error, targets ...error) bool {
+	found := false
+	visit(err, nil, func(e error) bool {
+		for _, target := range targets {
+			if target != nil && matches(e, target) {
+				found = true
+				return false
+			}
+		}
+		return true
+	})
+	return found
+}
+
+// IsAll reports whether every target is matched by any error in err's tree.
+// The tree is walked like errors.Is does, but also includes foreign multi-errors recognized by Splitters.
+// Returns true if no targets are given.
+func IsAll(err error, targets ...error) bool {
+	missing := make([]error, 0, len(targets))
+	for _, target := range targets {
+		if target != nil {
+			missing = append(missing, target)
+		}
+	}
+	if len(missing) == 0 {
+		return true
+	}
+
+	visit(err, nil, func(e error) bool {
+		for i := 0; i < len(missing); i++ {
+			if matches(e, missing[i]) {
+				missing = append(missing[:i], missing[i+1:]...)
+				i--
+			}
+		}
+		return len(missing) > 0
+	})
+	return len(missing) == 0
+}
@@ -0,0 +1,31 @@
+//go:build go1.21
+// +build go1.21
+
+package multierr
+
+// AsAll returns all errors in err's tree that are of type T, depth-first.
+// In contrast to errors.As, it does not stop at the first match.
+//
+// The tree contains all sub-errors of (nested) multi-errors, wrapped errors (like fmt.Errorf("%w"))
+// and foreign multi-errors recognized by Splitters.
+// Errors implementing "As(interface{}) bool" are supported like in errors.As.
+//
+// Requires Go 1.21. As go.mod declares an older language version,
+// generics are only enabled for files that require Go 1.21 or later.
+func AsAll[T error](err error) []T {
+	var result []T
+	visit(err, nil, func(e error) bool {
+		if t, ok := e.(T); ok {
+			result = append(result, t)
+			return true
+		}
+		if x, ok := e.(interface{ As(interface{}) bool }); ok {
+			var t T
+			if x.As(&t) {
+				result = append(result, t)
+			}
+		}
+		return true
+	})
+	return result
+}
@@ -0,0 +1,71 @@
+//go:build go1.21
+// +build go1.21
+
+package multierr
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type asErr struct{}
+
+func (asErr) Error() string { return "as" }
+
+func (asErr) As(target interface{}) bool {
+	if t, ok := target.(**os.PathError); ok {
+		*t = &os.PathError{Op: "as", Path: "as", Err: io.EOF}
+		return true
+	}
+	return false
+}
+
+func TestAsAll(t *testing.T) {
+	pathErr1 := &os.PathError{Op: "open", Path: "a", Err: os.ErrNotExist}
+	pathErr2 := &os.PathError{Op: "open", Path: "b", Err: os.ErrPermission}
+	pathErr3 := &os.PathError{Op: "open", Path: "c", Err: os.ErrClosed}
+
+	err := Append(
+		errors.New("x"),
+		pathErr1,
+		fmt.Errorf("wrapped: %w", Append(nil, pathErr2)),
+		&hashicorpErr{[]error{pathErr3}},
+		asErr{},
+	)
+
+	pathErrs := AsAll[*os.PathError](err)
+	if assert.Len(t, pathErrs, 4) {
+		assert.Same(t, pathErr1, pathErrs[0])
+		assert.Same(t, pathErr2, pathErrs[1])
+		assert.Same(t, pathErr3, pathErrs[2])
+		assert.Equal(t, "as", pathErrs[3].Op)
+	}
+
+	assert.Empty(t, AsAll[*os.LinkError](err))
+	assert.Empty(t, AsAll[*os.PathError](nil))
+}
+
+func TestAsAll_fieldErrors(t *testing.T) {
+	err := MergeField(errors.New("general"), "a", errors.New("x"), errors.New("y"))
+	err = Append(err, fmt.Errorf("context: %w", MergeField(nil, "b", errors.New("z"))))
+
+	fieldErrs := AsAll[*FieldError](err)
+	assert.Len(t, fieldErrs, 3)
+	assert.Equal(t, "a", fieldErrs[0].Path.String())
+	assert.Equal(t, "a", fieldErrs[1].Path.String())
+	assert.Equal(t, "b", fieldErrs[2].Path.String())
+
+	// nested multi-errors are matched as well
+	assert.Len(t, AsAll[*Error](err), 2)
+}
+
+func TestAsAll_cycle(t *testing.T) {
+	a, _ := manualCycle()
+	assert.Len(t, AsAll[*Error](a), 2)
+	assert.Len(t, AsAll[*FieldError](a), 1)
+}
@@ -0,0 +1,66 @@
+package multierr
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type isErr struct{}
+
+func (isErr) Error() string { return "is" }
+
+func (isErr) Is(target error) bool { return target == io.ErrClosedPipe }
+
+func TestIsAny(t *testing.T) {
+	err := Append(
+		errors.New("a"),
+		fmt.Errorf("wrapped: %w", Append(nil, io.EOF)),
+		&hashicorpErr{[]error{os.ErrNotExist}},
+		isErr{},
+	)
+
+	assert.True(t, IsAny(err, io.EOF))
+	assert.True(t, IsAny(err, io.ErrUnexpectedEOF, os.ErrNotExist))
+	assert.True(t, IsAny(err, io.ErrClosedPipe))
+	assert.True(t, IsAny(err, err))
+	assert.False(t, IsAny(err, io.ErrUnexpectedEOF))
+	assert.False(t, IsAny(err))
+	assert.False(t, IsAny(err, nil))
+	assert.False(t, IsAny(nil, io.EOF))
+}
+
+func TestIsAll(t *testing.T) {
+	err := Append(
+		errors.New("a"),
+		fmt.Errorf("wrapped: %w", Append(nil, io.EOF)),
+		&uberErr{[]error{os.ErrNotExist}},
+	)
+
+	assert.True(t, IsAll(err, io.EOF))
+	assert.True(t, IsAll(err, io.EOF, os.ErrNotExist, io.EOF))
+	assert.False(t, IsAll(err, io.EOF, io.ErrUnexpectedEOF))
+	assert.True(t, IsAll(err))
+	assert.True(t, IsAll(err, nil))
+	assert.False(t, IsAll(nil, io.EOF))
+}
+
+func TestIsAny_cycle(t *testing.T) {
+	a, _ := manualCycle()
+	assert.True(t, IsAny(a, io.EOF))
+	assert.False(t, IsAny(a, io.ErrUnexpectedEOF))
+	assert.False(t, IsAll(a, io.EOF, io.ErrUnexpectedEOF))
+}
+
+type uncomparableErr []string
+
+func (uncomparableErr) Error() string { return "uncomparable" }
+
+func TestIsAny_uncomparable(t *testing.T) {
+	err := Append(uncomparableErr{"a"}, errors.New("b"))
+	assert.False(t, IsAny(err, uncomparableErr{"a"}))
+}
@@ -2,6 +2,8 @@ package multierr
 
 import (
 	"fmt"
+	"io"
+	"strconv"
 	"strings"
 )
 
@@ -11,37 +13,43 @@ type FormatterFunc func([]error) string
 
 // ListFormatterFunc puts each sub-error in a new line.
 // All errors will be indented and titled with a generic "n errors occurred".
+// See NewListFormatter for a configurable variant.
 func ListFormatterFunc(errs []error) string {
-	if len(errs) == 0 {
-		return "no errors occurred"
-	}
 	//if len(errs) == 1 { // This might yield better results in most cases, but it would be breaking and surprising - it would make things more difficult to understand and reason about.
 	//	return errs[0].Error()
 	//}
+	return defaultListFormatter(errs)
+}
+
+var defaultListFormatter = NewListFormatter()
+
+// resolveFormatter returns the given formatter or, if it is nil, the current DefaultFormatter.
+// Formatters that wrap other formatters must resolve them on construction.
+// Otherwise, assigning the wrapper to DefaultFormatter would cause infinite recursion.
+func resolveFormatter(formatter FormatterFunc) FormatterFunc {
+	if formatter != nil {
+		return formatter
+	}
+	if DefaultFormatter != nil {
+		return DefaultFormatter
+	}
+	return ListFormatterFunc
+}
 
+// genericTitle returns "n errors occurred:".
+func genericTitle(count int) string {
 	plural := "errors"
-	if len(errs) == 1 {
+	if count == 1 {
 		plural = "error"
 	}
-	title := fmt.Sprintf("%d %s occurred:", len(errs), plural)
-	return TitledListFormatter(title)(errs)
+	return fmt.Sprintf("%d %s occurred:", count, plural)
 }
 
 // TitledListFormatter returns a formatter func that puts each sub-error in a new, indented line.
 // The errors are titled with the given text.
+// See NewListFormatter for a configurable variant.
 func TitledListFormatter(title string) FormatterFunc {
-	return func(errs []error) string {
-		if len(errs) == 0 {
-			return "no errors occurred"
-		}
-
-		var str = title
-		for _, err := range errs {
-			msg := strings.Replace(err.Error(), "\n", "\n    ", -1)
-			str += "\n  - " + msg
-		}
-		return str
-	}
+	return NewListFormatter(ListTitle(title))
 }
 
 // PrefixedListFormatter returns a formatter func that puts each sub-error in a new line.
@@ -61,3 +69,90 @@ func PrefixedListFormatter(prefix string) FormatterFunc {
 		return str[1:]
 	}
 }
+
+// Format implements fmt.Formatter.
+// All verbs are consistent with the error's formatter (see Error.Error):
+//   - "%v" and "%s" print the error message.
+//   - "%+v" prints the error message, followed by a detailed tree of all (nested) sub-errors
+//     including their stack traces (see CaptureStacks).
+//   - "%#v" prints a Go-syntax representation of the error tree.
+//   - "%q" prints the error message as double-quoted string.
+//
+// Width, precision and flags are applied to the error message.
+func (e *Error) Format(s fmt.State, verb rune) {
+	switch verb {
+	case 'v':
+		if s.Flag('#') {
+			_, _ = io.WriteString(s, e.acyclic().goSyntax())
+			return
+		}
+		if s.Flag('+') {
+			_, _ = io.WriteString(s, e.Error()+"\n"+e.acyclic().details(""))
+			return
+		}
+		_, _ = fmt.Fprintf(s, directive(s, 's'), e.Error())
+	case 's', 'q':
+		_, _ = fmt.Fprintf(s, directive(s, verb), e.Error())
+	default:
+		_, _ = fmt.Fprintf(s, "%%!%c(*multierr.Error=%s)", verb, e.Error())
+	}
+}
+
+// directive reconstructs the formatting directive with all flags, width and precision.
+func directive(s fmt.State, verb rune) string {
+	d := "%"
+	for _, flag := range "+-# 0" {
+		if s.Flag(int(flag)) {
+			d += string(flag)
+		}
+	}
+	if width, ok := s.Width(); ok {
+		d += strconv.Itoa(width)
+	}
+	if prec, ok := s.Precision(); ok {
+		d += "." + strconv.Itoa(prec)
+	}
+	return d + string(verb)
+}
+
+// details returns one line per (nested) sub-error, labeled with its index path.
+// Only the first line of each error message is printed, followed by the stack trace (if any).
+func (e *Error) details(label string) string {
+	var sb strings.Builder
+	for i, err := range e.Errors {
+		idx := label + strconv.Itoa(i)
+		msg := strings.SplitN(err.Error(), "\n", 2)[0]
+		sb.WriteString("\n[" + idx + "] " + msg)
+		e.writeStack(&sb, i)
+
+		if nested, ok := err.(*Error); ok && nested != nil {
+			sb.WriteString(nested.details(idx + "."))
+		}
+	}
+	return sb.String()
+}
+
+// goSyntax returns a Go-syntax representation of the error tree.
+// Titles and prefixes are represented as calls to Titled and Prefixed.
+// Custom formatters are omitted.
+func (e *Error) goSyntax() string {
+	if e == nil {
+		return "(*multierr.Error)(nil)"
+	}
+	errs := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		if nested, ok := err.(*Error); ok {
+			errs[i] = nested.goSyntax()
+		} else {
+			errs[i] = fmt.Sprintf("%#v", err)
+		}
+	}
+	str := "&multierr.Error{Errors: []error{" + strings.Join(errs, ", ") + "}}"
+	if e.title != "" {
+		return "multierr.Titled(" + str + ", " + strconv.Quote(e.title) + ")"
+	}
+	if e.prefix != "" {
+		return "multierr.Prefixed(" + str + ", " + strconv.Quote(e.prefix) + ")"
+	}
+	return str
+}
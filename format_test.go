@@ -2,6 +2,7 @@ package multierr
 
 import (
 	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -183,3 +184,64 @@ func Test_CustomFormatterFunc(t *testing.T) {
 
 	assert.Equal(t, "custom format", err.Error())
 }
+
+func TestError_Format(t *testing.T) {
+	DefaultFormatter = ListFormatterFunc
+	err := Append(errors.New("a"), errors.New("b"))
+
+	assert.Equal(t, err.Error(), fmt.Sprintf("%v", err))
+	assert.Equal(t, err.Error(), fmt.Sprintf("%s", err))
+	assert.Equal(t, `"2 errors occurred:\n  - a\n  - b"`, fmt.Sprintf("%q", err))
+	assert.Equal(t, "2 errors", fmt.Sprintf("%.8s", err))
+	assert.Equal(t, "%!d(*multierr.Error=2 errors occurred:\n  - a\n  - b)", fmt.Sprintf("%d", err))
+
+	err = Prefixed(err, "prefix: ")
+	assert.Equal(t, "      prefix: a\nprefix: b", fmt.Sprintf("%25v", err))
+	assert.Equal(t, "prefix: a\nprefix: b      ", fmt.Sprintf("%-25s", err))
+
+	err = Prefixed(Append(nil, errors.New("a")), "prefix: ")
+	assert.Equal(t, "`prefix: a`", fmt.Sprintf("%#q", err))
+}
+
+func TestError_Format_detailed(t *testing.T) {
+	DefaultFormatter = ListFormatterFunc
+	err := Append(
+		errors.New("a"),
+		Titled(Append(nil, errors.New("b\nsecond line")), "nested:"),
+		MergeField(nil, "field", errors.New("c")),
+	)
+
+	expected := "3 errors occurred:\n" +
+		"  - a\n" +
+		"  - nested:\n" +
+		"      - b\n" +
+		"        second line\n" +
+		"  - 1 error occurred:\n" +
+		"      - field: c\n" +
+		"\n" +
+		"[0] a\n" +
+		"[1] nested:\n" +
+		"[1.0] b\n" +
+		"[2] 1 error occurred:\n" +
+		"[2.0] field: c"
+	assert.Equal(t, expected, fmt.Sprintf("%+v", err))
+}
+
+func TestError_Format_goSyntax(t *testing.T) {
+	err := Append(
+		errors.New("a"),
+		Titled(Append(nil, errors.New("b")), "nested:"),
+		Prefixed(Append(nil, errors.New("c")), "prefix: "),
+	)
+	err.(*Error).Formatter = func([]error) string { return "custom" }
+
+	expected := `&multierr.Error{Errors: []error{` +
+		`&errors.errorString{s:"a"}, ` +
+		`multierr.Titled(&multierr.Error{Errors: []error{&errors.errorString{s:"b"}}}, "nested:"), ` +
+		`multierr.Prefixed(&multierr.Error{Errors: []error{&errors.errorString{s:"c"}}}, "prefix: ")` +
+		`}}`
+	assert.Equal(t, expected, fmt.Sprintf("%#v", err))
+
+	var typedNil *Error
+	assert.Equal(t, "(*multierr.Error)(nil)", fmt.Sprintf("%#v", typedNil))
+}
@@ -0,0 +1,134 @@
+package multierr
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+	"sync"
+)
+
+// Group runs tasks in separate go-routines and collects all their errors.
+// In contrast to golang.org/x/sync/errgroup, all errors are kept, not only the first one.
+//
+// The zero value is ready to use, does not limit the number of active go-routines
+// and does not cancel on error. A Group must not be copied after first use.
+type Group struct {
+	cancel func()
+	wg     sync.WaitGroup
+	sem    chan struct{}
+
+	mu   sync.Mutex
+	errs []error // indexed by submission order
+}
+
+// WithContext returns a new Group and an associated Context derived from ctx.
+// The derived Context is canceled the first time a function passed to Go
+// returns a non-nil error or panics, or the first time Wait returns, whichever occurs first.
+func WithContext(ctx context.Context) (*Group, context.Context) {
+	ctx, cancel := context.WithCancel(ctx)
+	return &Group{cancel: cancel}, ctx
+}
+
+// SetLimit limits the number of active go-routines in this group to at most n.
+// A negative value indicates no limit.
+// Any subsequent call to Go will block until it can add an active go-routine without exceeding the limit.
+//
+// The limit must not be modified while any go-routines in the group are active.
+func (g *Group) SetLimit(n int) {
+	if n < 0 {
+		g.sem = nil
+		return
+	}
+	if len(g.sem) != 0 {
+		panic(fmt.Errorf("multierr: modify limit while %d go-routines in the group are still active", len(g.sem)))
+	}
+	g.sem = make(chan struct{}, n)
+}
+
+// Go calls the given function in a new go-routine.
+// It blocks until the new go-routine can be added without exceeding the configured limit.
+//
+// Returned errors are collected and returned by Wait.
+// Panics are recovered and collected as *PanicError.
+func (g *Group) Go(f func() error) {
+	if g.sem != nil {
+		g.sem <- struct{}{}
+	}
+
+	g.mu.Lock()
+	idx := len(g.errs)
+	g.errs = append(g.errs, nil)
+	g.mu.Unlock()
+
+	g.wg.Add(1)
+	go func() {
+		defer g.done()
+
+		err := run(f)
+		if err == nil {
+			return
+		}
+		g.mu.Lock()
+		g.errs[idx] = err
+		g.mu.Unlock()
+
+		if g.cancel != nil {
+			g.cancel()
+		}
+	}()
+}
+
+func (g *Group) done() {
+	if g.sem != nil {
+		<-g.sem
+	}
+	g.wg.Done()
+}
+
+func run(f func() error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = &PanicError{
+				Value: r,
+				Stack: debug.Stack(),
+			}
+		}
+	}()
+	return f()
+}
+
+// Wait blocks until all function calls from the Go method have returned.
+// Returns all collected errors, or nil if there are none.
+// A returned error will always be of type *Error.
+//
+// The errors are listed in the order the functions were passed to Go,
+// independent of the order in which they finished.
+func (g *Group) Wait() error {
+	g.wg.Wait()
+	if g.cancel != nil {
+		g.cancel()
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return Append(nil, g.errs...)
+}
+
+// PanicError is collected by a Group if a function panics.
+type PanicError struct {
+	// Value is the value passed to panic.
+	Value interface{}
+	// Stack is the stack trace of the panicking go-routine.
+	Stack []byte
+}
+
+// Error implements the error interface.
+func (p *PanicError) Error() string {
+	return fmt.Sprintf("panic: %v", p.Value)
+}
+
+// Unwrap returns the panic value if it is an error, or nil otherwise.
+func (p *PanicError) Unwrap() error {
+	err, _ := p.Value.(error)
+	return err
+}
@@ -0,0 +1,161 @@
+package multierr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGroup_noErrors(t *testing.T) {
+	var g Group
+	assert.NoError(t, g.Wait())
+
+	for i := 0; i < 10; i++ {
+		g.Go(func() error {
+			return nil
+		})
+	}
+	assert.NoError(t, g.Wait())
+}
+
+func TestGroup_submissionOrder(t *testing.T) {
+	DefaultFormatter = ListFormatterFunc
+	var g Group
+	for i := 0; i < 5; i++ {
+		i := i
+		g.Go(func() error {
+			// finish in reverse order
+			time.Sleep(time.Duration(5-i) * 5 * time.Millisecond)
+			if i == 2 {
+				return nil
+			}
+			return fmt.Errorf("err %d", i)
+		})
+	}
+
+	err := g.Wait()
+	assert.EqualError(t, err, "4 errors occurred:\n"+
+		"  - err 0\n"+
+		"  - err 1\n"+
+		"  - err 3\n"+
+		"  - err 4")
+}
+
+func TestGroup_nestedMultiErrors(t *testing.T) {
+	DefaultFormatter = ListFormatterFunc
+	var g Group
+	g.Go(func() error {
+		return Append(errors.New("a"), errors.New("b"))
+	})
+	g.Go(func() error {
+		return errors.New("c")
+	})
+
+	err := g.Wait()
+	assert.Len(t, Inspect(err), 2)
+	assert.EqualError(t, err, "2 errors occurred:\n"+
+		"  - 2 errors occurred:\n"+
+		"      - a\n"+
+		"      - b\n"+
+		"  - c")
+}
+
+func TestGroup_panic(t *testing.T) {
+	var g Group
+	g.Go(func() error {
+		panic("boom")
+	})
+	g.Go(func() error {
+		panic(io.EOF)
+	})
+
+	err := g.Wait()
+	errs := Inspect(err)
+	assert.Len(t, errs, 2)
+
+	var panicErr *PanicError
+	assert.True(t, errors.As(errs[0], &panicErr))
+	assert.Equal(t, "boom", panicErr.Value)
+	assert.NotEmpty(t, panicErr.Stack)
+	assert.EqualError(t, panicErr, "panic: boom")
+	assert.Nil(t, panicErr.Unwrap())
+
+	assert.True(t, errors.Is(errs[1], io.EOF))
+}
+
+func TestGroup_SetLimit(t *testing.T) {
+	var g Group
+	g.SetLimit(2)
+
+	var active, maxActive int32
+	for i := 0; i < 20; i++ {
+		g.Go(func() error {
+			cur := atomic.AddInt32(&active, 1)
+			for {
+				old := atomic.LoadInt32(&maxActive)
+				if cur <= old || atomic.CompareAndSwapInt32(&maxActive, old, cur) {
+					break
+				}
+			}
+			time.Sleep(time.Millisecond)
+			atomic.AddInt32(&active, -1)
+			return errors.New("err")
+		})
+	}
+
+	err := g.Wait()
+	assert.Len(t, Inspect(err), 20)
+	assert.LessOrEqual(t, maxActive, int32(2))
+
+	g.SetLimit(-1)
+	assert.Nil(t, g.sem)
+}
+
+func TestGroup_SetLimit_whileActive(t *testing.T) {
+	var g Group
+	g.SetLimit(1)
+
+	release := make(chan struct{})
+	g.Go(func() error {
+		<-release
+		return nil
+	})
+	assert.Panics(t, func() {
+		g.SetLimit(2)
+	})
+	close(release)
+	assert.NoError(t, g.Wait())
+}
+
+func TestWithContext(t *testing.T) {
+	g, ctx := WithContext(context.Background())
+
+	g.Go(func() error {
+		return errors.New("err")
+	})
+	g.Go(func() error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+
+	err := g.Wait()
+	errs := Inspect(err)
+	assert.Len(t, errs, 2)
+	assert.EqualError(t, errs[0], "err")
+	assert.True(t, errors.Is(errs[1], context.Canceled))
+}
+
+func TestWithContext_cancelOnWait(t *testing.T) {
+	g, ctx := WithContext(context.Background())
+	g.Go(func() error {
+		return nil
+	})
+	assert.NoError(t, g.Wait())
+	assert.Error(t, ctx.Err())
+}
@@ -0,0 +1,114 @@
+// Package html renders errors as self-contained HTML reports.
+//
+// Multi-errors are rendered as nested lists.
+// Every (nested) multi-error is collapsible via a <details> element that shows its title and error count.
+// All error messages are escaped.
+package html
+
+import (
+	"fmt"
+	stdhtml "html"
+	"strings"
+
+	"github.com/maja42/multierr"
+)
+
+// Style contains the CSS rules that are embedded by Page.
+// Fragments only contain class attributes and can be styled by the embedding page.
+const Style = `.multierr { font-family: sans-serif; }
+.multierr summary { cursor: pointer; font-weight: bold; }
+.multierr .count { color: #666; font-weight: normal; }
+.multierr ul { margin: 0.25em 0; padding-left: 1.5em; }
+.multierr .field { font-family: monospace; color: #a00; }
+.multierr pre { margin: 0; white-space: pre-wrap; }
+.multierr .cycle, .multierr .dropped { color: #666; font-style: italic; }`
+
+// Fragment renders the error as HTML fragment.
+// Returns an empty string if the error is nil.
+//
+// If err is a *multierr.Error, it is rendered as <details> element that contains a list of all sub-errors.
+// The summary contains the title of the multi-error (see multierr.Titled),
+// or a generic "n errors occurred" if there is none.
+// Nested multi-errors are collapsed, sub-errors of type *multierr.FieldError are rendered with their field path.
+// Multi-line messages are rendered within <pre> elements.
+func Fragment(err error) string {
+	if err == nil {
+		return ""
+	}
+	var sb strings.Builder
+	sb.WriteString(`<div class="multierr">`)
+	mErr, ok := err.(*multierr.Error)
+	if ok && mErr != nil {
+		writeDetails(&sb, multierr.Acyclic(mErr).(*multierr.Error), true)
+	} else {
+		sb.WriteString("<p>")
+		writeMessage(&sb, err)
+		sb.WriteString("</p>")
+	}
+	sb.WriteString("</div>")
+	return sb.String()
+}
+
+// Page renders the error as self-contained HTML document with the given page title.
+// The document embeds the CSS rules of Style and the error fragment (see Fragment).
+func Page(err error, title string) string {
+	var sb strings.Builder
+	sb.WriteString("<!DOCTYPE html>\n<html>\n<head>\n")
+	sb.WriteString(`<meta charset="utf-8">` + "\n")
+	sb.WriteString("<title>" + stdhtml.EscapeString(title) + "</title>\n")
+	sb.WriteString("<style>\n" + Style + "\n</style>\n")
+	sb.WriteString("</head>\n<body>\n")
+	sb.WriteString("<h1>" + stdhtml.EscapeString(title) + "</h1>\n")
+	if err == nil {
+		sb.WriteString("<p>no errors occurred</p>\n")
+	} else {
+		sb.WriteString(Fragment(err) + "\n")
+	}
+	sb.WriteString("</body>\n</html>\n")
+	return sb.String()
+}
+
+// writeDetails writes a multi-error as collapsible list.
+// The multi-error must not contain cycles (see multierr.Acyclic).
+func writeDetails(sb *strings.Builder, mErr *multierr.Error, open bool) {
+	if open {
+		sb.WriteString("<details open>")
+	} else {
+		sb.WriteString("<details>")
+	}
+	sb.WriteString("<summary>" + stdhtml.EscapeString(mErr.Summary()))
+	fmt.Fprintf(sb, ` <span class="count">(%d)</span></summary>`, mErr.Len())
+
+	sb.WriteString("<ul>")
+	for _, err := range mErr.Errors {
+		sb.WriteString("<li>")
+		nested, ok := err.(*multierr.Error)
+		switch {
+		case err == multierr.ErrCycle:
+			sb.WriteString(`<span class="cycle">&lt;cycle&gt;</span>`)
+		case !ok || nested == nil:
+			writeMessage(sb, err)
+		default:
+			writeDetails(sb, nested, false)
+		}
+		sb.WriteString("</li>")
+	}
+	if dropped := mErr.Dropped(); dropped > 0 {
+		fmt.Fprintf(sb, `<li class="dropped">... and %d more errors (dropped)</li>`, dropped)
+	}
+	sb.WriteString("</ul></details>")
+}
+
+// writeMessage writes the escaped error message.
+func writeMessage(sb *strings.Builder, err error) {
+	msg := err.Error()
+	if fieldErr, ok := err.(*multierr.FieldError); ok && len(fieldErr.Path) > 0 {
+		sb.WriteString(`<span class="field">` + stdhtml.EscapeString(fieldErr.Path.String()) + "</span>: ")
+		msg = fieldErr.Err.Error()
+	}
+	if strings.Contains(msg, "\n") {
+		sb.WriteString("<pre>" + stdhtml.EscapeString(msg) + "</pre>")
+		return
+	}
+	sb.WriteString(stdhtml.EscapeString(msg))
+}
@@ -0,0 +1,82 @@
+package html
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/maja42/multierr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFragment_nilError(t *testing.T) {
+	assert.Equal(t, "", Fragment(nil))
+}
+
+func TestFragment_simpleError(t *testing.T) {
+	assert.Equal(t, `<div class="multierr"><p>&lt;b&gt;bold&lt;/b&gt; &amp; more</p></div>`,
+		Fragment(errors.New("<b>bold</b> & more")))
+}
+
+func TestFragment_multiError(t *testing.T) {
+	err := multierr.Append(
+		errors.New("missing name"),
+		multierr.Titled(multierr.Append(nil, errors.New("missing <city>"), errors.New("line 1\nline 2")), "invalid address"),
+	)
+	err = multierr.MergeField(err, "items", multierr.MergeIndex(nil, 3, multierr.MergeField(nil, "name", errors.New("too long"))))
+
+	assert.Equal(t, `<div class="multierr">`+
+		`<details open><summary>3 errors occurred <span class="count">(3)</span></summary><ul>`+
+		`<li>missing name</li>`+
+		`<li><details><summary>invalid address <span class="count">(2)</span></summary><ul>`+
+		`<li>missing &lt;city&gt;</li>`+
+		`<li><pre>line 1`+"\n"+`line 2</pre></li>`+
+		`</ul></details></li>`+
+		`<li><span class="field">items[3].name</span>: too long</li>`+
+		`</ul></details>`+
+		`</div>`, Fragment(err))
+}
+
+func TestFragment_escapedTitle(t *testing.T) {
+	err := multierr.Titled(errors.New("err"), `<script>alert("x")</script>`)
+	assert.Contains(t, Fragment(err), `<summary>&lt;script&gt;alert(&#34;x&#34;)&lt;/script&gt; <span class="count">(1)</span></summary>`)
+}
+
+func TestFragment_cycle(t *testing.T) {
+	a := &multierr.Error{Errors: []error{errors.New("a1")}}
+	b := &multierr.Error{Errors: []error{errors.New("b1"), a}}
+	a.Errors = append(a.Errors, b)
+
+	assert.Equal(t, `<div class="multierr">`+
+		`<details open><summary>2 errors occurred <span class="count">(2)</span></summary><ul>`+
+		`<li>a1</li>`+
+		`<li><details><summary>2 errors occurred <span class="count">(2)</span></summary><ul>`+
+		`<li>b1</li>`+
+		`<li><span class="cycle">&lt;cycle&gt;</span></li>`+
+		`</ul></details></li>`+
+		`</ul></details>`+
+		`</div>`, Fragment(a))
+}
+
+func TestFragment_dropped(t *testing.T) {
+	err := multierr.Bounded(&multierr.Error{}, 1, multierr.KeepFirst)
+	err = multierr.Append(err, errors.New("a"), errors.New("b"), errors.New("c"))
+
+	assert.Equal(t, `<div class="multierr">`+
+		`<details open><summary>3 errors occurred <span class="count">(3)</span></summary><ul>`+
+		`<li>a</li>`+
+		`<li class="dropped">... and 2 more errors (dropped)</li>`+
+		`</ul></details>`+
+		`</div>`, Fragment(err))
+}
+
+func TestPage(t *testing.T) {
+	page := Page(multierr.Append(nil, errors.New("failed")), "Import <report>")
+	assert.True(t, strings.HasPrefix(page, "<!DOCTYPE html>\n"))
+	assert.Contains(t, page, "<title>Import &lt;report&gt;</title>")
+	assert.Contains(t, page, "<h1>Import &lt;report&gt;</h1>")
+	assert.Contains(t, page, "<style>\n"+Style+"\n</style>")
+	assert.Contains(t, page, "<li>failed</li>")
+
+	assert.Contains(t, Page(nil, "Import"), "<p>no errors occurred</p>")
+}
@@ -0,0 +1,87 @@
+package httperr
+
+import (
+	"errors"
+	"net/http"
+)
+
+// StatusFunc maps an error to an HTTP status code.
+type StatusFunc func(err error) int
+
+// StatusCoder can be implemented by errors to provide their own HTTP status code.
+type StatusCoder interface {
+	StatusCode() int
+}
+
+// DefaultStatus is the StatusFunc used by handlers that don't specify their own.
+// If any (sub-)error implements StatusCoder, its status code is used.
+// Otherwise, http.StatusInternalServerError is returned.
+var DefaultStatus StatusFunc = func(err error) int {
+	var coder StatusCoder
+	if errors.As(err, &coder) {
+		return coder.StatusCode()
+	}
+	return http.StatusInternalServerError
+}
+
+// ExposeServerErrors enables error details in server error responses (status 500 and above) of handlers.
+// By default, these responses only contain the status code and title,
+// as the messages of internal errors might leak sensitive information to clients.
+var ExposeServerErrors = false
+
+// StatusMapping maps errors matching Target (via errors.Is) to Status.
+type StatusMapping struct {
+	Target error
+	Status int
+}
+
+// MapStatus returns a StatusFunc that uses the first mapping with a matching target.
+// If no target matches, the fallback StatusFunc is called, or DefaultStatus if it is nil.
+func MapStatus(fallback StatusFunc, mappings ...StatusMapping) StatusFunc {
+	if fallback == nil {
+		fallback = DefaultStatus
+	}
+	return func(err error) int {
+		for _, m := range mappings {
+			if errors.Is(err, m.Target) {
+				return m.Status
+			}
+		}
+		return fallback(err)
+	}
+}
+
+// HandlerFunc is an HTTP handler that can return an error.
+// If a non-nil error is returned, it is written as problem details response
+// with a status code determined by DefaultStatus.
+type HandlerFunc func(w http.ResponseWriter, r *http.Request) error
+
+// ServeHTTP implements http.Handler.
+func (f HandlerFunc) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	Handle(f, nil).ServeHTTP(w, r)
+}
+
+// Handle returns an http.Handler that calls h and converts returned errors into problem details responses.
+// The status code is determined by the given StatusFunc, or by DefaultStatus if it is nil.
+// The request path is used as problem instance.
+// Server errors don't contain any error details, unless ExposeServerErrors is set.
+//
+// The handler must not write the response if it returns an error.
+func Handle(h HandlerFunc, status StatusFunc) http.Handler {
+	if status == nil {
+		status = DefaultStatus
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		err := h(w, r)
+		if err == nil {
+			return
+		}
+		code := validStatus(status(err))
+		if code >= http.StatusInternalServerError && !ExposeServerErrors {
+			err = nil
+		}
+		problem := NewProblem(err, code)
+		problem.Instance = r.URL.Path
+		_ = problem.Write(w)
+	})
+}
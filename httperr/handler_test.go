@@ -0,0 +1,139 @@
+package httperr
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/maja42/multierr"
+	"github.com/stretchr/testify/assert"
+)
+
+type notFoundErr struct{}
+
+func (notFoundErr) Error() string   { return "not found" }
+func (notFoundErr) StatusCode() int { return http.StatusNotFound }
+
+func TestDefaultStatus(t *testing.T) {
+	assert.Equal(t, http.StatusInternalServerError, DefaultStatus(errors.New("err")))
+	assert.Equal(t, http.StatusNotFound, DefaultStatus(notFoundErr{}))
+	assert.Equal(t, http.StatusNotFound, DefaultStatus(multierr.Append(errors.New("err"), notFoundErr{})))
+}
+
+func TestMapStatus(t *testing.T) {
+	status := MapStatus(nil,
+		StatusMapping{Target: io.EOF, Status: http.StatusBadRequest},
+		StatusMapping{Target: io.ErrUnexpectedEOF, Status: http.StatusConflict},
+	)
+	assert.Equal(t, http.StatusBadRequest, status(io.EOF))
+	assert.Equal(t, http.StatusConflict, status(multierr.Append(errors.New("err"), io.ErrUnexpectedEOF)))
+	assert.Equal(t, http.StatusBadRequest, status(multierr.Append(io.ErrUnexpectedEOF, io.EOF)))
+	assert.Equal(t, http.StatusNotFound, status(notFoundErr{}))
+	assert.Equal(t, http.StatusInternalServerError, status(errors.New("err")))
+
+	status = MapStatus(func(error) int {
+		return http.StatusTeapot
+	})
+	assert.Equal(t, http.StatusTeapot, status(errors.New("err")))
+}
+
+func TestHandle(t *testing.T) {
+	handler := Handle(func(w http.ResponseWriter, r *http.Request) error {
+		if r.URL.Query().Get("fail") == "" {
+			_, _ = w.Write([]byte("ok"))
+			return nil
+		}
+		return multierr.Append(errors.New("a"), io.EOF)
+	}, MapStatus(nil, StatusMapping{Target: io.EOF, Status: http.StatusBadRequest}))
+
+	t.Run("success", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/items", nil))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "ok", rec.Body.String())
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/items?fail=1", nil))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Equal(t, ContentType, rec.Header().Get("Content-Type"))
+		assert.JSONEq(t, `{
+			"title": "Bad Request",
+			"status": 400,
+			"detail": "2 errors occurred",
+			"instance": "/items",
+			"errors": [
+				{"detail": "a"},
+				{"detail": "EOF"}
+			]
+		}`, rec.Body.String())
+	})
+}
+
+func TestHandlerFunc(t *testing.T) {
+	var handler http.Handler = HandlerFunc(func(w http.ResponseWriter, r *http.Request) error {
+		return notFoundErr{}
+	})
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/missing", nil))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	assert.JSONEq(t, `{
+		"title": "Not Found",
+		"status": 404,
+		"detail": "not found",
+		"instance": "/missing"
+	}`, rec.Body.String())
+}
+
+func TestHandle_serverError(t *testing.T) {
+	handler := Handle(func(w http.ResponseWriter, r *http.Request) error {
+		return multierr.Append(errors.New("connecting to 10.0.0.1: password rejected"), io.EOF)
+	}, nil)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/items", nil))
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	assert.JSONEq(t, `{
+		"title": "Internal Server Error",
+		"status": 500,
+		"instance": "/items"
+	}`, rec.Body.String())
+
+	ExposeServerErrors = true
+	defer func() {
+		ExposeServerErrors = false
+	}()
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/items", nil))
+	assert.JSONEq(t, `{
+		"title": "Internal Server Error",
+		"status": 500,
+		"detail": "2 errors occurred",
+		"instance": "/items",
+		"errors": [
+			{"detail": "connecting to 10.0.0.1: password rejected"},
+			{"detail": "EOF"}
+		]
+	}`, rec.Body.String())
+}
+
+func TestHandle_invalidStatus(t *testing.T) {
+	handler := Handle(func(w http.ResponseWriter, r *http.Request) error {
+		return errors.New("err")
+	}, func(error) int {
+		return 0
+	})
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/items", nil))
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	assert.JSONEq(t, `{
+		"title": "Internal Server Error",
+		"status": 500,
+		"instance": "/items"
+	}`, rec.Body.String())
+}
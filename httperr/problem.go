@@ -0,0 +1,157 @@
+// Package httperr renders errors as RFC 9457 "Problem Details for HTTP APIs".
+//
+// Multi-errors are rendered with an additional "errors" extension member
+// that contains one entry per sub-error.
+package httperr
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/maja42/multierr"
+)
+
+// ContentType is the media type of problem details documents.
+const ContentType = "application/problem+json"
+
+// Problem is an RFC 9457 problem details document.
+type Problem struct {
+	// Type is a URI reference that identifies the problem type.
+	// An empty type is equivalent to "about:blank".
+	Type string `json:"type,omitempty"`
+	// Title is a short, human-readable summary of the problem type.
+	Title string `json:"title,omitempty"`
+	// Status is the HTTP status code.
+	Status int `json:"status,omitempty"`
+	// Detail is a human-readable explanation specific to this occurrence of the problem.
+	Detail string `json:"detail,omitempty"`
+	// Instance is a URI reference that identifies the specific occurrence of the problem.
+	Instance string `json:"instance,omitempty"`
+	// Errors is an extension member containing all sub-errors of a multi-error.
+	Errors []ProblemError `json:"errors,omitempty"`
+}
+
+// ProblemError is a single entry within the "errors" extension member.
+type ProblemError struct {
+	// Detail is the error message.
+	// For field errors, the message does not contain the field path.
+	Detail string `json:"detail"`
+	// Field is the path of the invalid field, like "items[3].name".
+	// It is only set for errors of type *multierr.FieldError.
+	Field string `json:"field,omitempty"`
+	// Errors contains the sub-errors if the error is a nested multi-error.
+	Errors []ProblemError `json:"errors,omitempty"`
+}
+
+// NewProblem converts an error into a problem details document with the given status code.
+// Invalid status codes are replaced by http.StatusInternalServerError.
+//
+// If err is a *multierr.Error, each sub-error becomes an entry in Problem.Errors.
+// The detail contains the title of the multi-error (see multierr.Titled),
+// or a generic summary if there is none.
+// Foreign multi-errors recognized by multierr.Splitters (like errors.Join) are converted first.
+// If the multi-error is wrapped (like fmt.Errorf("%w")), the detail contains the wrapper's message instead.
+// Otherwise, the detail contains the error message.
+func NewProblem(err error, status int) *Problem {
+	status = validStatus(status)
+	problem := &Problem{
+		Title:  http.StatusText(status),
+		Status: status,
+	}
+	if err == nil {
+		return problem
+	}
+
+	mErr, wrapped := multiError(err)
+	if mErr == nil {
+		problem.Detail = err.Error()
+		return problem
+	}
+	mErr = multierr.Acyclic(mErr).(*multierr.Error)
+	problem.Detail = mErr.Summary()
+	if wrapped {
+		problem.Detail = err.Error()
+	}
+	problem.Errors = problemErrors(mErr.Errors)
+	return problem
+}
+
+// multiError returns the multi-error at the end of a chain of single-error wrappers.
+// Foreign multi-errors are converted. Returns nil if there is none.
+// Multi-errors nested within other multi-errors are not considered, as their siblings would be lost.
+func multiError(err error) (mErr *multierr.Error, wrapped bool) {
+	for e := err; e != nil; e = errors.Unwrap(e) {
+		if mErr, ok := e.(*multierr.Error); ok {
+			return mErr, e != err
+		}
+		if isForeignMultiError(e) {
+			mErr, _ := multierr.Convert(e).(*multierr.Error)
+			return mErr, e != err
+		}
+	}
+	return nil, false
+}
+
+// isForeignMultiError reports whether err is a multi-error recognized by multierr.Splitters.
+func isForeignMultiError(err error) bool {
+	for _, splitter := range multierr.Splitters {
+		if _, ok := splitter(err); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// problemErrors converts all errors into problem errors.
+// The errors must not contain cycles (see multierr.Acyclic).
+func problemErrors(errs []error) []ProblemError {
+	result := make([]ProblemError, len(errs))
+	for i, err := range errs {
+		if mErr, ok := err.(*multierr.Error); ok && mErr != nil {
+			result[i] = ProblemError{
+				Detail: mErr.Summary(),
+				Errors: problemErrors(mErr.Errors),
+			}
+			continue
+		}
+		if fieldErr, ok := multierr.AsFieldError(err); ok && len(fieldErr.Path) > 0 {
+			result[i] = ProblemError{
+				Detail: fieldErr.Err.Error(),
+				Field:  fieldErr.Path.String(),
+			}
+			continue
+		}
+		result[i] = ProblemError{
+			Detail: err.Error(),
+		}
+	}
+	return result
+}
+
+// validStatus returns the status code, or http.StatusInternalServerError if it is invalid.
+func validStatus(status int) int {
+	if status < 100 || status > 999 {
+		return http.StatusInternalServerError
+	}
+	return status
+}
+
+// Write writes the problem as JSON response.
+// Invalid status codes are replaced by http.StatusInternalServerError.
+func (p *Problem) Write(w http.ResponseWriter) error {
+	body, err := json.Marshal(p)
+	if err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", ContentType)
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(validStatus(p.Status))
+	_, err = w.Write(body)
+	return err
+}
+
+// Write converts the error into a problem details document and writes it as JSON response.
+func Write(w http.ResponseWriter, err error, status int) error {
+	return NewProblem(err, status).Write(w)
+}
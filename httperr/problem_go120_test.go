@@ -0,0 +1,27 @@
+//go:build go1.20
+// +build go1.20
+
+package httperr
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/maja42/multierr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewProblem_joinedMultiError(t *testing.T) {
+	err := errors.Join(errors.New("lost"), multierr.Append(errors.New("a"), errors.New("b")))
+
+	problem := NewProblem(err, http.StatusBadRequest)
+	assert.Equal(t, "2 errors occurred", problem.Detail)
+	assert.Equal(t, []ProblemError{
+		{Detail: "lost"},
+		{Detail: "2 errors occurred", Errors: []ProblemError{
+			{Detail: "a"},
+			{Detail: "b"},
+		}},
+	}, problem.Errors)
+}
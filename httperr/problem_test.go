@@ -0,0 +1,144 @@
+package httperr
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/maja42/multierr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewProblem_simpleError(t *testing.T) {
+	problem := NewProblem(errors.New("err"), http.StatusBadRequest)
+	assert.Equal(t, &Problem{
+		Title:  "Bad Request",
+		Status: http.StatusBadRequest,
+		Detail: "err",
+	}, problem)
+}
+
+func TestNewProblem_nilError(t *testing.T) {
+	problem := NewProblem(nil, http.StatusInternalServerError)
+	assert.Equal(t, &Problem{
+		Title:  "Internal Server Error",
+		Status: http.StatusInternalServerError,
+	}, problem)
+}
+
+func TestNewProblem_multiError(t *testing.T) {
+	err := multierr.Append(
+		errors.New("missing name"),
+		multierr.Titled(multierr.Append(nil, errors.New("missing city"), errors.New("missing street")), "invalid address"),
+	)
+
+	problem := NewProblem(err, http.StatusUnprocessableEntity)
+	assert.Equal(t, &Problem{
+		Title:  "Unprocessable Entity",
+		Status: http.StatusUnprocessableEntity,
+		Detail: "2 errors occurred",
+		Errors: []ProblemError{
+			{Detail: "missing name"},
+			{
+				Detail: "invalid address",
+				Errors: []ProblemError{
+					{Detail: "missing city"},
+					{Detail: "missing street"},
+				},
+			},
+		},
+	}, problem)
+
+	problem = NewProblem(multierr.Titled(err, "invalid input"), http.StatusUnprocessableEntity)
+	assert.Equal(t, "invalid input", problem.Detail)
+}
+
+func TestWrite(t *testing.T) {
+	err := multierr.Titled(multierr.Append(errors.New("a"), errors.New("b")), "invalid input")
+
+	rec := httptest.NewRecorder()
+	assert.NoError(t, Write(rec, err, http.StatusBadRequest))
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Equal(t, ContentType, rec.Header().Get("Content-Type"))
+	assert.JSONEq(t, `{
+		"title": "Bad Request",
+		"status": 400,
+		"detail": "invalid input",
+		"errors": [
+			{"detail": "a"},
+			{"detail": "b"}
+		]
+	}`, rec.Body.String())
+}
+
+func TestWrite_invalidStatus(t *testing.T) {
+	rec := httptest.NewRecorder()
+	assert.NoError(t, Write(rec, errors.New("err"), 0))
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	assert.JSONEq(t, `{
+		"title": "Internal Server Error",
+		"status": 500,
+		"detail": "err"
+	}`, rec.Body.String())
+
+	rec = httptest.NewRecorder()
+	assert.NoError(t, (&Problem{Status: 1000}).Write(rec))
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+}
+
+func TestNewProblem_wrappedMultiError(t *testing.T) {
+	err := fmt.Errorf("handler: %w", multierr.Append(errors.New("a"), errors.New("b")))
+
+	problem := NewProblem(err, http.StatusBadRequest)
+	assert.Equal(t, &Problem{
+		Title:  "Bad Request",
+		Status: http.StatusBadRequest,
+		Detail: err.Error(),
+		Errors: []ProblemError{
+			{Detail: "a"},
+			{Detail: "b"},
+		},
+	}, problem)
+	assert.True(t, strings.HasPrefix(problem.Detail, "handler: "))
+}
+
+func TestNewProblem_fieldErrors(t *testing.T) {
+	err := multierr.MergeField(nil, "address", multierr.MergeField(nil, "street", errors.New("missing")))
+
+	problem := NewProblem(err, http.StatusBadRequest)
+	assert.Equal(t, []ProblemError{
+		{Detail: "missing", Field: "address.street"},
+	}, problem.Errors)
+}
+
+func TestNewProblem_nestedFieldErrors(t *testing.T) {
+	nested := multierr.MergeField(nil, "name", errors.New("missing"))
+	nested = multierr.Append(nested, errors.New("plain"))
+	nested = multierr.MergeField(nested, "age", errors.New("negative"))
+	err := multierr.Append(errors.New("x"), nested)
+
+	problem := NewProblem(err, http.StatusBadRequest)
+	assert.Equal(t, []ProblemError{
+		{Detail: "x"},
+		{Detail: "3 errors occurred", Errors: []ProblemError{
+			{Detail: "missing", Field: "name"},
+			{Detail: "plain"},
+			{Detail: "negative", Field: "age"},
+		}},
+	}, problem.Errors)
+}
+
+func TestNewProblem_cycle(t *testing.T) {
+	mErr := &multierr.Error{}
+	mErr.Errors = []error{errors.New("a"), mErr}
+
+	problem := NewProblem(mErr, http.StatusBadRequest)
+	assert.Equal(t, []ProblemError{
+		{Detail: "a"},
+		{Detail: "<cycle>"},
+	}, problem.Errors)
+}
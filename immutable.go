@@ -0,0 +1,70 @@
+package multierr
+
+import "fmt"
+
+// ImmutableAPI provides copy-on-write variants of the functions that modify multi-errors.
+// In contrast to their mutable counterparts, they never modify the passed errors.
+// Instead, a new *Error is returned.
+//
+// This prevents aliasing issues when multiple code paths append to the same multi-error.
+// Use the package-level variable Immutable to access these functions:
+//
+//	base := multierr.Append(nil, errA)
+//	err1 := multierr.Immutable.Append(base, errB) // base is not modified
+//	err2 := multierr.Immutable.Append(base, errC) // err1 is not affected
+type ImmutableAPI struct{}
+
+// Immutable provides copy-on-write variants of Append, Merge, MergePrefixed, Titled and Prefixed.
+// See ImmutableAPI for more information.
+var Immutable ImmutableAPI
+
+// clone returns a shallow copy of multi-errors, and all other errors unchanged.
+func clone(err error) error {
+	mErr, ok := err.(*Error)
+	if !ok || mErr == nil {
+		return err
+	}
+	c := *mErr
+	c.Errors = make([]error, len(mErr.Errors))
+	copy(c.Errors, mErr.Errors)
+	if mErr.stacks != nil {
+		c.stacks = make([][]uintptr, len(mErr.stacks))
+		copy(c.stacks, mErr.stacks)
+	}
+	return &c
+}
+
+// Append works like the package-level Append, but never modifies err.
+func (ImmutableAPI) Append(err error, errs ...error) error {
+	return combine(false, clone(err), "", errs...)
+}
+
+// Merge works like the package-level Merge, but never modifies err.
+func (ImmutableAPI) Merge(err error, errs ...error) error {
+	return combine(true, clone(err), "", errs...)
+}
+
+// MergePrefixed works like the package-level MergePrefixed, but never modifies err.
+func (ImmutableAPI) MergePrefixed(err error, prefix string, errs ...error) error {
+	return combine(true, clone(err), prefix, errs...)
+}
+
+// Titled works like the package-level Titled, but never modifies err.
+func (ImmutableAPI) Titled(err error, title string) error {
+	return Titled(clone(err), title)
+}
+
+// Titledf works like the package-level Titledf, but never modifies err.
+func (ImmutableAPI) Titledf(err error, format string, args ...interface{}) error {
+	return Titled(clone(err), fmt.Sprintf(format, args...))
+}
+
+// Prefixed works like the package-level Prefixed, but never modifies err.
+func (ImmutableAPI) Prefixed(err error, prefix string) error {
+	return Prefixed(clone(err), prefix)
+}
+
+// Prefixedf works like the package-level Prefixedf, but never modifies err.
+func (ImmutableAPI) Prefixedf(err error, format string, args ...interface{}) error {
+	return Prefixed(clone(err), fmt.Sprintf(format, args...))
+}
@@ -0,0 +1,91 @@
+package multierr
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAppend_aliasing(t *testing.T) {
+	a, b, c := errors.New("a"), errors.New("b"), errors.New("c")
+
+	base := &Error{Errors: make([]error, 1, 10)}
+	base.Errors[0] = a
+
+	// the mutable API shares the backing array between branches
+	branch1 := Append(base, b)
+	branch2 := Append(base, c)
+	assert.Same(t, branch1, branch2)
+	assert.Equal(t, []error{a, b, c}, Inspect(branch1))
+}
+
+func TestImmutable_Append(t *testing.T) {
+	a, b, c := errors.New("a"), errors.New("b"), errors.New("c")
+
+	base := &Error{Errors: make([]error, 1, 10)}
+	base.Errors[0] = a
+
+	branch1 := Immutable.Append(base, b)
+	branch2 := Immutable.Append(base, c)
+
+	assert.Equal(t, []error{a}, Inspect(base))
+	assert.Equal(t, []error{a, b}, Inspect(branch1))
+	assert.Equal(t, []error{a, c}, Inspect(branch2))
+
+	// appending to a branch does not leak into other branches
+	branch3 := Append(branch1, c)
+	assert.Equal(t, []error{a, b, c}, Inspect(branch3))
+	assert.Equal(t, []error{a, c}, Inspect(branch2))
+
+	assert.Nil(t, Immutable.Append(nil))
+	assert.Equal(t, []error{a, b}, Inspect(Immutable.Append(a, b)))
+}
+
+func TestImmutable_Merge(t *testing.T) {
+	DefaultFormatter = ListFormatterFunc
+	a, b, c := errors.New("a"), errors.New("b"), errors.New("c")
+
+	base := Append(a)
+	merged := Immutable.Merge(base, Append(b, c))
+	assert.Equal(t, []error{a}, Inspect(base))
+	assert.Equal(t, []error{a, b, c}, Inspect(merged))
+
+	prefixed := Immutable.MergePrefixed(base, "prefix: ", Append(b, c))
+	assert.Equal(t, []error{a}, Inspect(base))
+	assert.EqualError(t, prefixed, "3 errors occurred:\n"+
+		"  - a\n"+
+		"  - prefix: b\n"+
+		"  - prefix: c")
+}
+
+func TestImmutable_Titled(t *testing.T) {
+	DefaultFormatter = ListFormatterFunc
+	base := Append(errors.New("a"))
+
+	titled := Immutable.Titled(base, "title")
+	titledf := Immutable.Titledf(base, "title %d", 2)
+	prefixed := Immutable.Prefixed(base, "prefix: ")
+	prefixedf := Immutable.Prefixedf(base, "prefix %d: ", 2)
+
+	assert.Equal(t, "1 error occurred:\n  - a", base.Error())
+	assert.Equal(t, "", base.(*Error).Title())
+	assert.Equal(t, "title\n  - a", titled.Error())
+	assert.Equal(t, "title 2\n  - a", titledf.Error())
+	assert.Equal(t, "prefix: a", prefixed.Error())
+	assert.Equal(t, "prefix 2: a", prefixedf.Error())
+
+	var typedNil *Error
+	assert.Equal(t, "no errors occurred", Immutable.Titled(typedNil, "title").Error())
+	assert.Nil(t, Immutable.Titled(nil, "title"))
+}
+
+func TestImmutable_bounded(t *testing.T) {
+	base := Bounded(manyErrors(3), 3, KeepLast)
+	branch := Immutable.Append(base, errors.New("err 3"))
+
+	assert.Equal(t, []string{"err 0", "err 1", "err 2"}, messages(Inspect(base)))
+	assert.Zero(t, base.(*Error).Dropped())
+	assert.Equal(t, []string{"err 1", "err 2", "err 3"}, messages(Inspect(branch)))
+	assert.Equal(t, 1, branch.(*Error).Dropped())
+}
@@ -0,0 +1,120 @@
+package multierr
+
+// SplitFunc splits a foreign multi-error into its sub-errors.
+// Returns false if err is not a multi-error that is known by the SplitFunc.
+type SplitFunc func(err error) ([]error, bool)
+
+// Splitters are used by Merge, MergePrefixed, Inspect and Convert to recognize
+// multi-errors that are not of type *Error.
+// The first SplitFunc that returns true is used.
+//
+// By default, the following errors are recognized:
+//   - Errors implementing "Unwrap() []error", like the ones returned by errors.Join
+//   - Errors implementing "WrappedErrors() []error", like github.com/hashicorp/go-multierror
+//   - Errors implementing "Errors() []error", like github.com/uber-go/multierr
+//
+// Additional libraries can be supported by adding custom SplitFuncs.
+var Splitters = []SplitFunc{
+	splitUnwrapper,
+	splitWrappedErrors,
+	splitErrors,
+}
+
+type unwrapper interface {
+	Unwrap() []error
+}
+
+type wrappedErrorser interface {
+	WrappedErrors() []error
+}
+
+type errorser interface {
+	Errors() []error
+}
+
+func splitUnwrapper(err error) ([]error, bool) {
+	if e, ok := err.(unwrapper); ok {
+		return e.Unwrap(), true
+	}
+	return nil, false
+}
+
+func splitWrappedErrors(err error) ([]error, bool) {
+	if e, ok := err.(wrappedErrorser); ok {
+		return e.WrappedErrors(), true
+	}
+	return nil, false
+}
+
+func splitErrors(err error) ([]error, bool) {
+	if e, ok := err.(errorser); ok {
+		return e.Errors(), true
+	}
+	return nil, false
+}
+
+// split returns the sub-errors of a foreign multi-error.
+// Nil-errors are removed.
+// Returns false if the error is not a foreign multi-error.
+func split(err error) ([]error, bool) {
+	if _, ok := err.(*Error); ok {
+		return nil, false
+	}
+	for _, splitter := range Splitters {
+		errs, ok := splitter(err)
+		if !ok {
+			continue
+		}
+		result := make([]error, 0, len(errs))
+		for _, e := range errs {
+			if e != nil {
+				result = append(result, e)
+			}
+		}
+		return result, true
+	}
+	return nil, false
+}
+
+// Convert converts foreign multi-errors that are recognized by Splitters into *Error.
+// Nested foreign multi-errors are converted as well.
+// Returns nil if err is nil or an empty multi-error.
+// Otherwise, the result is always an *Error.
+//
+// If err is a multierr.Error, it is returned unchanged.
+// Any other error is converted into a *Error with a single sub-error.
+func Convert(err error) error {
+	if err == nil {
+		return nil
+	}
+	if mErr, ok := err.(*Error); ok {
+		if mErr == nil || len(mErr.Errors) == 0 {
+			return nil
+		}
+		return mErr
+	}
+	errs, ok := split(err)
+	if !ok {
+		return &Error{Errors: []error{err}}
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return convertSplit(errs)
+}
+
+func convertSplit(errs []error) *Error {
+	result := &Error{
+		Errors: make([]error, 0, len(errs)),
+	}
+	for _, e := range errs {
+		if sub, ok := split(e); ok {
+			if len(sub) == 0 {
+				continue
+			}
+			e = convertSplit(sub)
+		}
+		result.Errors = append(result.Errors, e)
+	}
+	return result
+}
@@ -0,0 +1,32 @@
+//go:build go1.20
+// +build go1.20
+
+package multierr
+
+import "errors"
+
+// Joined converts err into the representation of errors.Join from the standard library.
+// Nested multi-errors are converted as well.
+// Returns nil if there are no errors.
+//
+// This is useful when passing errors to code that depends on the exact error type.
+// Note that custom formatters are lost.
+func Joined(err error) error {
+	errs := Inspect(err)
+	if len(errs) == 0 {
+		return nil
+	}
+	joined := make([]error, len(errs))
+	for i, e := range errs {
+		if _, ok := e.(*Error); ok {
+			joined[i] = Joined(e)
+			continue
+		}
+		if _, ok := split(e); ok {
+			joined[i] = Joined(e)
+			continue
+		}
+		joined[i] = e
+	}
+	return errors.Join(joined...)
+}
@@ -0,0 +1,37 @@
+//go:build go1.20
+// +build go1.20
+
+package multierr
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMerge_errorsJoin(t *testing.T) {
+	a, b, c := errors.New("a"), errors.New("b"), errors.New("c")
+	result := Merge(a, errors.Join(b, nil, c))
+	assert.Equal(t, []error{a, b, c}, Inspect(result))
+
+	result = Merge(nil, fmt.Errorf("%w and %w", a, b))
+	assert.Equal(t, []error{a, b}, Inspect(result))
+}
+
+func TestJoined(t *testing.T) {
+	a, b, c := errors.New("a"), errors.New("b"), errors.New("c")
+
+	assert.Nil(t, Joined(nil))
+	assert.Nil(t, Joined(&Error{}))
+
+	joined := Joined(Append(a, Append(b, c)))
+	assert.EqualError(t, joined, "a\nb\nc")
+
+	errs := joined.(interface{ Unwrap() []error }).Unwrap()
+	assert.Len(t, errs, 2)
+	assert.Equal(t, a, errs[0])
+	assert.IsType(t, errors.Join(a), errs[1])
+	assert.True(t, errors.Is(joined, c))
+}
@@ -0,0 +1,135 @@
+package multierr
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// hashicorpErr mimics github.com/hashicorp/go-multierror
+type hashicorpErr struct {
+	errs []error
+}
+
+func (e *hashicorpErr) Error() string          { return "hashicorp" }
+func (e *hashicorpErr) WrappedErrors() []error { return e.errs }
+
+// uberErr mimics github.com/uber-go/multierr
+type uberErr struct {
+	errs []error
+}
+
+func (e *uberErr) Error() string   { return "uber" }
+func (e *uberErr) Errors() []error { return e.errs }
+
+// joinErr mimics errors.Join
+type joinErr struct {
+	errs []error
+}
+
+func (e *joinErr) Error() string {
+	var msgs []string
+	for _, err := range e.errs {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "\n")
+}
+func (e *joinErr) Unwrap() []error { return e.errs }
+
+func TestMerge_foreignMultiErrors(t *testing.T) {
+	DefaultFormatter = ListFormatterFunc
+	a, b, c, d := errors.New("a"), errors.New("b"), errors.New("c"), errors.New("d")
+
+	result := Merge(nil,
+		&hashicorpErr{[]error{a}},
+		&uberErr{[]error{b, nil}},
+		&joinErr{[]error{c, d}},
+	)
+	assert.Equal(t, []error{a, b, c, d}, Inspect(result))
+
+	result = MergePrefixed(nil, "prefix: ", &joinErr{[]error{a, b}})
+	assert.EqualError(t, result, "2 errors occurred:\n"+
+		"  - prefix: a\n"+
+		"  - prefix: b")
+}
+
+func TestMerge_emptyForeignMultiErrors(t *testing.T) {
+	result := Merge(nil, &hashicorpErr{}, &uberErr{[]error{nil}}, &joinErr{})
+	assert.NoError(t, result)
+
+	result = MergePrefixed(nil, "prefix: ", &hashicorpErr{})
+	assert.NoError(t, result)
+}
+
+func TestAppend_foreignMultiErrors(t *testing.T) {
+	foreign := &joinErr{[]error{errors.New("a"), errors.New("b")}}
+	result := Append(nil, foreign)
+	assert.Equal(t, []error{foreign}, Inspect(result))
+}
+
+func TestInspect_foreignMultiErrors(t *testing.T) {
+	a, b := errors.New("a"), errors.New("b")
+	assert.Equal(t, []error{a, b}, Inspect(&uberErr{[]error{a, b}}))
+	assert.Nil(t, Inspect(&hashicorpErr{}))
+}
+
+type customErr struct {
+	errs []error
+}
+
+func (e *customErr) Error() string { return "custom" }
+
+func TestSplitters_custom(t *testing.T) {
+	original := Splitters
+	defer func() { Splitters = original }()
+
+	a, b := errors.New("a"), errors.New("b")
+	custom := &customErr{[]error{a, b}}
+	assert.Equal(t, []error{custom}, Inspect(custom))
+
+	Splitters = append(Splitters, func(err error) ([]error, bool) {
+		if e, ok := err.(*customErr); ok {
+			return e.errs, true
+		}
+		return nil, false
+	})
+	assert.Equal(t, []error{a, b}, Inspect(custom))
+	assert.Equal(t, []error{a, b}, Inspect(Merge(nil, custom)))
+}
+
+func TestConvert(t *testing.T) {
+	a, b, c := errors.New("a"), errors.New("b"), errors.New("c")
+
+	t.Run("nil error", func(t *testing.T) {
+		assert.Nil(t, Convert(nil))
+		var typedNil *Error
+		assert.Nil(t, Convert(typedNil))
+		assert.Nil(t, Convert(&Error{}))
+		assert.Nil(t, Convert(&joinErr{}))
+	})
+
+	t.Run("simple error", func(t *testing.T) {
+		result := Convert(a)
+		assert.Equal(t, &Error{Errors: []error{a}}, result)
+	})
+
+	t.Run("multi error", func(t *testing.T) {
+		mErr := Append(a, b)
+		assert.Same(t, mErr, Convert(mErr))
+	})
+
+	t.Run("nested foreign errors", func(t *testing.T) {
+		foreign := &hashicorpErr{[]error{
+			a,
+			&uberErr{[]error{b, c}},
+			&joinErr{},
+		}}
+		result := Convert(foreign)
+		assert.Equal(t, &Error{Errors: []error{
+			a,
+			&Error{Errors: []error{b, c}},
+		}}, result)
+	})
+}
@@ -0,0 +1,118 @@
+package multierr
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// jsonError is the JSON representation of an error.
+// Multi-errors always contain an error list (which might be empty), other errors never do.
+type jsonError struct {
+	Message string       `json:"message"`
+	Field   string       `json:"field,omitempty"`
+	Title   string       `json:"title,omitempty"`
+	Prefix  string       `json:"prefix,omitempty"`
+	Errors  *[]jsonError `json:"errors,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler.
+// The error is encoded as a structured document containing the error message,
+// the title and prefix (if set via Titled or Prefixed) and all sub-errors.
+// Nested multi-errors are encoded recursively.
+// Sub-errors of type *FieldError contain the field path and their message without the path.
+//
+// Example:
+//
+//	{
+//	  "message": "invalid input:\n  - missing name",
+//	  "title": "invalid input:",
+//	  "errors": [
+//	    {"message": "missing name"}
+//	  ]
+//	}
+func (e *Error) MarshalJSON() ([]byte, error) {
+	if e == nil {
+		return []byte("null"), nil
+	}
+	return json.Marshal(toJSONError(e.acyclic()))
+}
+
+func toJSONError(err error) jsonError {
+	if fieldErr, ok := err.(*FieldError); ok && len(fieldErr.Path) > 0 {
+		return jsonError{
+			Message: fieldErr.Err.Error(),
+			Field:   fieldErr.Path.String(),
+		}
+	}
+	mErr, ok := err.(*Error)
+	if !ok || mErr == nil {
+		return jsonError{
+			Message: err.Error(),
+		}
+	}
+
+	errs := make([]jsonError, len(mErr.Errors))
+	for i, e := range mErr.Errors {
+		errs[i] = toJSONError(e)
+	}
+	return jsonError{
+		Message: mErr.Error(),
+		Title:   mErr.title,
+		Prefix:  mErr.prefix,
+		Errors:  &errs,
+	}
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It rebuilds the error tree that was encoded by MarshalJSON.
+// Titles and prefixes are restored via Titled and Prefixed, field paths as *FieldError.
+// All other sub-errors are restored as simple errors that only retain their message.
+func (e *Error) UnmarshalJSON(data []byte) error {
+	var doc jsonError
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return err
+	}
+	if doc.Errors == nil {
+		return errors.New("multierr: cannot unmarshal JSON without error list into *Error")
+	}
+	mErr, err := fromJSONError(doc)
+	if err != nil {
+		return err
+	}
+	*e = *mErr.(*Error)
+	return nil
+}
+
+func fromJSONError(doc jsonError) (error, error) {
+	if doc.Errors == nil {
+		if doc.Field == "" {
+			return errors.New(doc.Message), nil
+		}
+		path, err := ParseFieldPath(doc.Field)
+		if err != nil {
+			return nil, err
+		}
+		return &FieldError{
+			Path: path,
+			Err:  errors.New(doc.Message),
+		}, nil
+	}
+
+	mErr := &Error{
+		Errors: make([]error, len(*doc.Errors)),
+	}
+	for i, e := range *doc.Errors {
+		sub, err := fromJSONError(e)
+		if err != nil {
+			return nil, err
+		}
+		mErr.Errors[i] = sub
+	}
+	if doc.Title != "" {
+		return Titled(mErr, doc.Title), nil
+	}
+	if doc.Prefix != "" {
+		return Prefixed(mErr, doc.Prefix), nil
+	}
+	return mErr, nil
+}
@@ -0,0 +1,91 @@
+package multierr
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestError_MarshalJSON(t *testing.T) {
+	DefaultFormatter = ListFormatterFunc
+
+	err := Titled(Append(
+		errors.New("missing name"),
+		Titled(Append(nil, errors.New("missing city")), "invalid address:"),
+		Prefixed(Append(nil, errors.New("too long")), "street: "),
+		Append(nil, errors.New("a")),
+	), "invalid input:")
+
+	data, jsonErr := json.Marshal(err)
+	assert.NoError(t, jsonErr)
+	assert.JSONEq(t, `{
+		"message": "invalid input:\n  - missing name\n  - invalid address:\n      - missing city\n  - street: too long\n  - 1 error occurred:\n      - a",
+		"title": "invalid input:",
+		"errors": [
+			{"message": "missing name"},
+			{
+				"message": "invalid address:\n  - missing city",
+				"title": "invalid address:",
+				"errors": [{"message": "missing city"}]
+			},
+			{
+				"message": "street: too long",
+				"prefix": "street: ",
+				"errors": [{"message": "too long"}]
+			},
+			{
+				"message": "1 error occurred:\n  - a",
+				"errors": [{"message": "a"}]
+			}
+		]
+	}`, string(data))
+}
+
+func TestError_MarshalJSON_empty(t *testing.T) {
+	DefaultFormatter = ListFormatterFunc
+
+	data, err := json.Marshal(&Error{})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"message": "no errors occurred", "errors": []}`, string(data))
+
+	var typedNil *Error
+	data, err = json.Marshal(typedNil)
+	assert.NoError(t, err)
+	assert.Equal(t, "null", string(data))
+}
+
+func TestError_UnmarshalJSON_roundTrip(t *testing.T) {
+	DefaultFormatter = ListFormatterFunc
+
+	original := Titled(Append(
+		errors.New("missing name"),
+		Titled(Append(nil, errors.New("missing city\nsecond line")), "invalid address:"),
+		Prefixed(Append(nil, errors.New("too long")), "street: "),
+		&Error{},
+	), "invalid input:")
+
+	data, err := json.Marshal(original)
+	assert.NoError(t, err)
+
+	var restored Error
+	assert.NoError(t, json.Unmarshal(data, &restored))
+	assert.Equal(t, original.Error(), restored.Error())
+	assert.Equal(t, "invalid input:", restored.Title())
+
+	nested := restored.Errors[1].(*Error)
+	assert.Equal(t, "invalid address:", nested.Title())
+	nested = restored.Errors[2].(*Error)
+	assert.Equal(t, "street: ", nested.Prefix())
+
+	data2, err := json.Marshal(&restored)
+	assert.NoError(t, err)
+	assert.JSONEq(t, string(data), string(data2))
+}
+
+func TestError_UnmarshalJSON_invalid(t *testing.T) {
+	var mErr Error
+	assert.Error(t, json.Unmarshal([]byte(`{"message": "no list"}`), &mErr))
+	assert.Error(t, json.Unmarshal([]byte(`[]`), &mErr))
+}
@@ -0,0 +1,136 @@
+package multierr
+
+import (
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// ListOption configures formatters that are created via NewListFormatter.
+type ListOption func(*listFormatter)
+
+type listFormatter struct {
+	title        string
+	titled       bool
+	pluralize    func(count int) string
+	indent       string
+	bullet       string
+	numbered     bool
+	continuation *string
+	empty        string
+}
+
+// ListTitle sets a fixed title. By default, a generic title is used (see ListPluralization).
+func ListTitle(title string) ListOption {
+	return func(f *listFormatter) {
+		f.title, f.titled = title, true
+	}
+}
+
+// ListPluralization sets the function that returns the generic title for the given number of errors.
+// This allows translating titles like "1 error occurred:" and "2 errors occurred:".
+// Ignored if a fixed title is set via ListTitle.
+func ListPluralization(title func(count int) string) ListOption {
+	return func(f *listFormatter) {
+		f.pluralize = title
+	}
+}
+
+// ListIndent sets the indentation of each bullet point. Defaults to two spaces.
+func ListIndent(indent string) ListOption {
+	return func(f *listFormatter) {
+		f.indent = indent
+	}
+}
+
+// ListBullet sets the bullet that precedes each error. Defaults to "- ".
+func ListBullet(bullet string) ListOption {
+	return func(f *listFormatter) {
+		f.bullet = bullet
+	}
+}
+
+// ListNumbered uses numbered bullets, like "1. " and "2. ", instead of ListBullet.
+func ListNumbered() ListOption {
+	return func(f *listFormatter) {
+		f.numbered = true
+	}
+}
+
+// ListContinuationIndent sets the indentation of subsequent lines of multi-line errors (like nested multi-errors).
+// By default, subsequent lines are aligned with the first line of the message.
+func ListContinuationIndent(indent string) ListOption {
+	return func(f *listFormatter) {
+		f.continuation = &indent
+	}
+}
+
+// ListEmptyMessage sets the message that is returned if there are no errors.
+// Defaults to "no errors occurred".
+func ListEmptyMessage(msg string) ListOption {
+	return func(f *listFormatter) {
+		f.empty = msg
+	}
+}
+
+// NewListFormatter returns a formatter func that puts each sub-error in a new, indented line.
+// Without options, the formatter is equivalent to ListFormatterFunc:
+//
+//	2 errors occurred:
+//	  - first error
+//	  - second error
+//
+// Example for a numbered, German list:
+//
+//	multierr.NewListFormatter(
+//		multierr.ListNumbered(),
+//		multierr.ListPluralization(func(count int) string {
+//			return fmt.Sprintf("%d Fehler aufgetreten:", count)
+//		}),
+//	)
+func NewListFormatter(opts ...ListOption) FormatterFunc {
+	f := &listFormatter{
+		pluralize: genericTitle,
+		indent:    "  ",
+		bullet:    "- ",
+		empty:     "no errors occurred",
+	}
+	for _, opt := range opts {
+		opt(f)
+	}
+	if f.pluralize == nil {
+		f.pluralize = genericTitle
+	}
+	return f.format
+}
+
+func (f *listFormatter) format(errs []error) string {
+	if len(errs) == 0 {
+		return f.empty
+	}
+	title := f.title
+	if !f.titled {
+		title = f.pluralize(countErrors(errs))
+	}
+
+	var sb strings.Builder
+	sb.WriteString(title)
+	for i, err := range errs {
+		bullet := f.bullet
+		if f.numbered {
+			bullet = numberedBullet(i + 1)
+		}
+		continuation := f.indent + strings.Repeat(" ", utf8.RuneCountInString(bullet))
+		if f.continuation != nil {
+			continuation = *f.continuation
+		}
+		msg := strings.Replace(err.Error(), "\n", "\n"+continuation, -1)
+		sb.WriteString("\n" + f.indent + bullet + msg)
+	}
+	return sb.String()
+}
+
+// numberedBullet returns the numbered bullet, like "3. ".
+func numberedBullet(n int) string {
+	return strconv.Itoa(n) + ". "
+}
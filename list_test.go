@@ -0,0 +1,98 @@
+package multierr
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewListFormatter_defaults(t *testing.T) {
+	errs := []error{
+		errors.New("a"),
+		errors.New("multi\nline"),
+		Titled(Append(nil, errors.New("b")), "nested:"),
+	}
+	formatter := NewListFormatter()
+	assert.Equal(t, ListFormatterFunc(errs), formatter(errs))
+	assert.Equal(t, "3 errors occurred:\n"+
+		"  - a\n"+
+		"  - multi\n"+
+		"    line\n"+
+		"  - nested:\n"+
+		"      - b", formatter(errs))
+	assert.Equal(t, "no errors occurred", formatter(nil))
+}
+
+func TestNewListFormatter_options(t *testing.T) {
+	errs := []error{errors.New("a"), errors.New("multi\nline")}
+
+	formatter := NewListFormatter(
+		ListTitle("title"),
+		ListIndent("\t"),
+		ListBullet("• "),
+		ListEmptyMessage("ok"),
+	)
+	assert.Equal(t, "title\n"+
+		"\t• a\n"+
+		"\t• multi\n"+
+		"\t  line", formatter(errs))
+	assert.Equal(t, "ok", formatter(nil))
+
+	formatter = NewListFormatter(ListContinuationIndent(" > "))
+	assert.Equal(t, "2 errors occurred:\n"+
+		"  - a\n"+
+		"  - multi\n"+
+		" > line", formatter(errs))
+
+	formatter = NewListFormatter(ListTitle(""))
+	assert.Equal(t, "\n  - a\n  - multi\n    line", formatter(errs))
+}
+
+func TestNewListFormatter_numbered(t *testing.T) {
+	errs := make([]error, 10)
+	for i := range errs {
+		errs[i] = fmt.Errorf("err %d", i)
+	}
+	errs[0] = errors.New("first\nline")
+	errs[9] = errors.New("multi\nline")
+
+	str := NewListFormatter(ListNumbered(), ListBullet("ignored"))(errs)
+	assert.Equal(t, "10 errors occurred:\n"+
+		"  1. first\n"+
+		"     line\n"+
+		"  2. err 1\n"+
+		"  3. err 2\n"+
+		"  4. err 3\n"+
+		"  5. err 4\n"+
+		"  6. err 5\n"+
+		"  7. err 6\n"+
+		"  8. err 7\n"+
+		"  9. err 8\n"+
+		"  10. multi\n"+
+		"      line", str)
+}
+
+func TestNewListFormatter_pluralization(t *testing.T) {
+	german := func(count int) string {
+		if count == 1 {
+			return "Ein Fehler ist aufgetreten:"
+		}
+		return fmt.Sprintf("%d Fehler sind aufgetreten:", count)
+	}
+	formatter := NewListFormatter(ListPluralization(german))
+	assert.Equal(t, "Ein Fehler ist aufgetreten:\n  - a", formatter([]error{errors.New("a")}))
+	assert.Equal(t, "2 Fehler sind aufgetreten:\n  - a\n  - b", formatter([]error{errors.New("a"), errors.New("b")}))
+
+	// the title counts omitted errors
+	str := TruncatedFormatter(formatter, 1, 0)([]error{errors.New("a"), errors.New("b"), errors.New("c")})
+	assert.Equal(t, "3 Fehler sind aufgetreten:\n  - a\n  - ... and 2 more errors", str)
+
+	// fixed titles take precedence
+	formatter = NewListFormatter(ListPluralization(german), ListTitle("title"))
+	assert.Equal(t, "title\n  - a", formatter([]error{errors.New("a")}))
+
+	formatter = NewListFormatter(ListPluralization(nil))
+	assert.Equal(t, "1 error occurred:\n  - a", formatter([]error{errors.New("a")}))
+}
@@ -0,0 +1,117 @@
+package multierr
+
+import (
+	"strings"
+)
+
+// MarkdownFormatter returns a formatter func that renders errors as (nested) Markdown bullet lists.
+// The title is rendered as heading. If the title is empty, a generic "n errors occurred" heading is used.
+//
+// Markdown-significant characters in error messages are escaped.
+// Multi-line messages are put into fenced code blocks.
+// Nested multi-errors are rendered as nested lists, titled with their own title (see Titled).
+func MarkdownFormatter(title string) FormatterFunc {
+	return func(errs []error) string {
+		if len(errs) == 0 {
+			return "no errors occurred"
+		}
+		heading := title
+		if heading == "" {
+			heading = genericTitle(countErrors(errs))
+		}
+
+		var sb strings.Builder
+		sb.WriteString("### " + markdownEscape(heading) + "\n")
+		writeMarkdownList(&sb, errs, "")
+		return strings.TrimSuffix(sb.String(), "\n")
+	}
+}
+
+// Markdown sets the error formatter to a MarkdownFormatter.
+// The title that was set via Titled is used as heading.
+//
+// If the error is not a multierr.Error, it will be converted.
+// Returns nil if the error is nil. Otherwise, the result is always an *Error.
+func Markdown(err error) error {
+	mErr := toError(err)
+	if mErr == nil {
+		return nil
+	}
+	mErr.Formatter = MarkdownFormatter(mErr.title)
+	return mErr
+}
+
+func writeMarkdownList(sb *strings.Builder, errs []error, indent string) {
+	for _, err := range errs {
+		mErr, ok := err.(*Error)
+		if !ok || mErr == nil {
+			writeMarkdownItem(sb, err.Error(), indent)
+			continue
+		}
+		sub := mErr.visibleErrors()
+		title := mErr.title
+		if title == "" {
+			title = genericTitle(countErrors(sub))
+		}
+		sb.WriteString(indent + "- " + markdownEscape(title) + "\n")
+		writeMarkdownList(sb, sub, indent+"  ")
+	}
+}
+
+// writeMarkdownItem writes a single bullet point.
+// Multi-line messages are written as fenced code block.
+func writeMarkdownItem(sb *strings.Builder, msg string, indent string) {
+	if !strings.Contains(msg, "\n") {
+		sb.WriteString(indent + "- " + markdownEscape(msg) + "\n")
+		return
+	}
+
+	// the fence must be longer than any backtick sequence within the message
+	fence := "```"
+	for strings.Contains(msg, fence) {
+		fence += "`"
+	}
+	contentIndent := indent + "  "
+	sb.WriteString(indent + "- " + fence + "\n")
+	for _, line := range strings.Split(msg, "\n") {
+		sb.WriteString(contentIndent + line + "\n")
+	}
+	sb.WriteString(contentIndent + fence + "\n")
+}
+
+// markdownReplacer escapes characters that have a special meaning within Markdown text.
+var markdownReplacer = strings.NewReplacer(
+	`\`, `\\`,
+	"`", "\\`",
+	`*`, `\*`,
+	`_`, `\_`,
+	`[`, `\[`,
+	`]`, `\]`,
+	`<`, `\<`,
+	`>`, `\>`,
+	`#`, `\#`,
+	`|`, `\|`,
+	`~`, `\~`,
+)
+
+// markdownEscape escapes the given single-line text.
+// Characters that only have a special meaning at the beginning of a line (like list markers) are escaped as well.
+func markdownEscape(text string) string {
+	text = markdownReplacer.Replace(text)
+	if text == "" {
+		return text
+	}
+	switch text[0] {
+	case '-', '+', '=':
+		return `\` + text
+	}
+	// ordered list markers, like "1." or "1)"
+	digits := 0
+	for digits < len(text) && text[digits] >= '0' && text[digits] <= '9' {
+		digits++
+	}
+	if digits > 0 && digits < len(text) && (text[digits] == '.' || text[digits] == ')') {
+		return text[:digits] + `\` + text[digits:]
+	}
+	return text
+}
@@ -0,0 +1,80 @@
+package multierr
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarkdownFormatter(t *testing.T) {
+	assert.Equal(t, "no errors occurred", MarkdownFormatter("title")(nil))
+
+	str := MarkdownFormatter("")([]error{
+		errors.New("a"),
+		Titled(Append(nil, errors.New("b"), Append(nil, errors.New("c"))), "nested:"),
+	})
+	assert.Equal(t, "### 2 errors occurred:\n"+
+		"- a\n"+
+		"- nested:\n"+
+		"  - b\n"+
+		"  - 1 error occurred:\n"+
+		"    - c", str)
+}
+
+func TestMarkdownFormatter_multiline(t *testing.T) {
+	str := MarkdownFormatter("Validation failed")([]error{
+		errors.New("first\nsecond"),
+		Append(nil, errors.New("code:\n```go\nx := 1\n```")),
+	})
+	assert.Equal(t, "### Validation failed\n"+
+		"- ```\n"+
+		"  first\n"+
+		"  second\n"+
+		"  ```\n"+
+		"- 1 error occurred:\n"+
+		"  - ````\n"+
+		"    code:\n"+
+		"    ```go\n"+
+		"    x := 1\n"+
+		"    ```\n"+
+		"    ````", str)
+}
+
+func TestMarkdownEscape(t *testing.T) {
+	assert.Equal(t, "", markdownEscape(""))
+	assert.Equal(t, "plain text, with punctuation: file.go!", markdownEscape("plain text, with punctuation: file.go!"))
+	assert.Equal(t, `\*bold\* \_it\_ \`+"`code\\`"+` \[link\](url) \<b\> \#1 a\|b \~x\~ c:\\dir`,
+		markdownEscape("*bold* _it_ `code` [link](url) <b> #1 a|b ~x~ c:\\dir"))
+	assert.Equal(t, `\- item`, markdownEscape("- item"))
+	assert.Equal(t, `\+ item`, markdownEscape("+ item"))
+	assert.Equal(t, `\===`, markdownEscape("==="))
+	assert.Equal(t, `12\. item`, markdownEscape("12. item"))
+	assert.Equal(t, `3\) item`, markdownEscape("3) item"))
+	assert.Equal(t, `123`, markdownEscape("123"))
+	assert.Equal(t, `a-b`, markdownEscape("a-b"))
+}
+
+func TestMarkdown(t *testing.T) {
+	assert.Nil(t, Markdown(nil))
+
+	err := Markdown(Titled(Append(nil, errors.New("missing *name*")), "Invalid config"))
+	assert.Equal(t, "### Invalid config\n"+
+		`- missing \*name\*`, err.Error())
+	assert.Equal(t, "Invalid config", err.(*Error).Title())
+
+	err = Markdown(errors.New("a"))
+	assert.Equal(t, "### 1 error occurred:\n- a", err.Error())
+}
+
+func TestMarkdown_cycle(t *testing.T) {
+	a, _ := manualCycle()
+	err := Markdown(a)
+	assert.Equal(t, "### 3 errors occurred:\n"+
+		"- a1\n"+
+		"- EOF\n"+
+		"- 3 errors occurred:\n"+
+		"  - b1\n"+
+		"  - \\<cycle\\>\n"+
+		"  - \\<cycle\\>", err.Error())
+}
@@ -2,6 +2,7 @@ package multierr
 
 import (
 	"fmt"
+	"strings"
 )
 
 // DefaultFormatter specifies the error formatter that is used for errors that
@@ -13,16 +14,68 @@ var DefaultFormatter = ListFormatterFunc
 type Error struct {
 	Formatter FormatterFunc
 	Errors    []error
+
+	title    string          // set via Titled
+	prefix   string          // set via Prefixed
+	truncate *TruncateLimits // set via Truncated
+	stock    uintptr         // code pointer of the formatter set via Titled or Truncated (see setStockFormatter)
+
+	captureStacks bool        // set via WithStacks
+	stacks        [][]uintptr // stack trace per error, or nil if stacks were never captured
+
+	capacity int            // set via Bounded
+	overflow OverflowPolicy // set via Bounded
+	dropped  int            // number of errors that exceeded the capacity
+
+	checked bool // copy created by acyclic, known to be free of cycles
 }
 
 // Error converts the error into a human readable string.
 // Uses the error-specific formatter or, if none is specified, the DefaultFormatter.
+// Dropped sub-errors are replaced by a placeholder before being passed to the formatter (see Bounded).
+// Cyclic sub-errors are replaced by "<cycle>".
 func (e *Error) Error() string {
 	formatter := e.Formatter
 	if formatter == nil {
 		formatter = DefaultFormatter
 	}
-	return formatter(e.Errors)
+	return formatter(e.acyclic().formattedErrors())
+}
+
+// Title returns the title that was set via Titled or Titledf.
+// Returns an empty string if there is none.
+func (e *Error) Title() string {
+	if e == nil {
+		return ""
+	}
+	return e.title
+}
+
+// Prefix returns the prefix that was set via Prefixed or Prefixedf.
+// Returns an empty string if there is none.
+func (e *Error) Prefix() string {
+	if e == nil {
+		return ""
+	}
+	return e.prefix
+}
+
+// Len returns the number of sub-errors, including the ones that were dropped because of a capacity (see Bounded).
+// Nested multi-errors are counted as a single error.
+func (e *Error) Len() int {
+	if e == nil {
+		return 0
+	}
+	return len(e.Errors) + e.dropped
+}
+
+// Summary returns the title that was set via Titled or Titledf.
+// If there is none, a generic summary like "3 errors occurred" is returned.
+func (e *Error) Summary() string {
+	if title := e.Title(); title != "" {
+		return title
+	}
+	return strings.TrimSuffix(genericTitle(e.Len()), ":")
 }
 
 // Titled sets the error formatter to a TitledListFormatter.
@@ -30,9 +83,15 @@ func (e *Error) Error() string {
 //
 // If the error is not a multierr.Error, it will be converted.
 // Returns nil if the error is nil. Otherwise, the result is always an *Error.
-// This is equivalent of setting Error.Formatter directly.
+// Apart from recording the title (see Error.Title), this is equivalent of setting Error.Formatter directly.
 func Titled(err error, title string) error {
-	return withCustomFormatter(err, TitledListFormatter(title))
+	mErr := withCustomFormatter(err, TitledListFormatter(title))
+	if mErr == nil {
+		return nil
+	}
+	mErr.setStockFormatter(mErr.Formatter)
+	mErr.title, mErr.prefix = title, ""
+	return mErr
 }
 
 // Titledf sets the error formatter to a TitledListFormatter.
@@ -47,9 +106,14 @@ func Titledf(err error, format string, args ...interface{}) error {
 //
 // If the error is not a multierr.Error, it will be converted.
 // Returns nil if the error is nil. Otherwise, the result is always an *Error.
-// This is equivalent of setting Error.Formatter directly.
+// Apart from recording the prefix (see Error.Prefix), this is equivalent of setting Error.Formatter directly.
 func Prefixed(err error, prefix string) error {
-	return withCustomFormatter(err, PrefixedListFormatter(prefix))
+	mErr := withCustomFormatter(err, PrefixedListFormatter(prefix))
+	if mErr == nil {
+		return nil
+	}
+	mErr.title, mErr.prefix = "", prefix
+	return mErr
 }
 
 // Prefixedf sets the error formatter to a PrefixedListFormatter.
@@ -59,7 +123,19 @@ func Prefixedf(err error, format string, args ...interface{}) error {
 	return Prefixed(err, title)
 }
 
-func withCustomFormatter(err error, formatter FormatterFunc) error {
+func withCustomFormatter(err error, formatter FormatterFunc) *Error {
+	mErr := toError(err)
+	if mErr == nil {
+		return nil
+	}
+	mErr.Formatter = formatter
+	mErr.truncate = nil // the truncated formatter was replaced
+	return mErr
+}
+
+// toError converts the error into a *Error, or returns nil if the error is nil.
+// Other error types are converted into a *Error with a single sub-error.
+func toError(err error) *Error {
 	if err == nil {
 		return nil
 	}
@@ -67,7 +143,7 @@ func withCustomFormatter(err error, formatter FormatterFunc) error {
 	mErr, ok := err.(*Error)
 	if mErr == nil && ok {
 		// typed nil-error
-		// special case: do not return nil. Return empty *Error that contains the custom formatter.
+		// special case: do not return nil. Return empty *Error that can be configured.
 	}
 	if mErr == nil {
 		mErr = &Error{}
@@ -75,12 +151,12 @@ func withCustomFormatter(err error, formatter FormatterFunc) error {
 	if !ok { // convert error type
 		mErr.Errors = []error{err}
 	}
-	mErr.Formatter = formatter
 	return mErr
 }
 
 // Append combines all errors into a single multi-error.
 // Any nil-error will be ignored. Returns nil if there are no errors.
+// Errors that would create a cycle (because they contain err) are ignored as well.
 // A returned error will always be of type *Error.
 //
 // If err is a multierr.Error, it will be reused (the title and error-slice are kept).
@@ -93,7 +169,8 @@ func Append(err error, errs ...error) error {
 // Any nil-error will be ignored. Returns nil if there are no errors.
 // A returned error will always be of type *Error.
 //
-// If any errs is a multierr.Error, it will be flattened.
+// If any errs is a multierr.Error or a foreign multi-error recognized by Splitters, it will be flattened.
+// Errors that would create a cycle (because they contain err) are ignored.
 //
 // If err is a multierr.Error, it will be reused (the title and error-slice are kept).
 // Otherwise, a new multierr.Error is created.
@@ -105,7 +182,9 @@ func Merge(err error, errs ...error) error {
 // Any nil-error will be ignored. Returns nil if there are no errors.
 // A returned error will always be of type *Error.
 //
-// If any errs is a multierr.Error, it will be flattened. Custom formatters are ignored.
+// If any errs is a multierr.Error or a foreign multi-error recognized by Splitters, it will be flattened.
+// Custom formatters are ignored.
+// Errors that would create a cycle (because they contain err) are ignored.
 // Every merged error in errs will be wrapped using the provided prefix.
 //
 // If err is a multierr.Error, it will be reused (the formatter and error-slice are kept).
@@ -116,13 +195,14 @@ func MergePrefixed(err error, prefix string, errs ...error) error {
 
 func combine(flatten bool, err error, errsPrefix string, errs ...error) error {
 	result, ok := err.(*Error)
+	checkCycles := result != nil // a new multi-error can't be referenced by errs
 	if result == nil {
 		result = &Error{
 			Errors: make([]error, 0, len(errs)+1),
 		}
 	}
 	if !ok && err != nil { // err was not a multi error
-		result.Errors = append(result.Errors, err)
+		result.add(err)
 	}
 
 	for _, e := range errs {
@@ -134,20 +214,36 @@ func combine(flatten bool, err error, errsPrefix string, errs ...error) error {
 			continue
 		}
 
+		var subErrors []error
+		if ok {
+			subErrors = multiErr.Errors
+		} else if flatten {
+			subErrors, ok = split(e)
+			if ok && len(subErrors) == 0 {
+				continue
+			}
+		}
+
 		if ok && flatten {
-			subErrors := multiErr.Errors
+			if checkCycles {
+				subErrors = withoutCycles(result, subErrors)
+			}
 			if errsPrefix != "" {
-				subErrors = make([]error, len(multiErr.Errors))
-				for i, err := range multiErr.Errors {
-					subErrors[i] = fmt.Errorf("%s%w", errsPrefix, err)
+				prefixed := make([]error, len(subErrors))
+				for i, err := range subErrors {
+					prefixed[i] = fmt.Errorf("%s%w", errsPrefix, err)
 				}
+				subErrors = prefixed
 			}
-			result.Errors = append(result.Errors, subErrors...)
+			result.add(subErrors...)
 		} else {
+			if checkCycles && createsCycle(result, e, make(map[*Error]bool)) {
+				continue
+			}
 			if errsPrefix != "" {
-				result.Errors = append(result.Errors, fmt.Errorf("%s%w", errsPrefix, e))
+				result.add(fmt.Errorf("%s%w", errsPrefix, e))
 			} else {
-				result.Errors = append(result.Errors, e)
+				result.add(e)
 			}
 		}
 	}
@@ -158,6 +254,7 @@ func combine(flatten bool, err error, errsPrefix string, errs ...error) error {
 }
 
 // Inspect returns all embedded sub-errors or nil if there are no errors.
+// Foreign multi-errors are recognized via Splitters.
 // If err is not a multi-error, an error-slice with one element is returned.
 func Inspect(err error) []error {
 	if err == nil {
@@ -165,6 +262,12 @@ func Inspect(err error) []error {
 	}
 	result, ok := err.(*Error)
 	if !ok {
+		if errs, ok := split(err); ok {
+			if len(errs) == 0 {
+				return nil
+			}
+			return errs
+		}
 		return []error{err}
 	}
 	if result == nil {
@@ -12,6 +12,8 @@ func Test_Titled(t *testing.T) {
 		orig := errors.New("err")
 		err := Titled(orig, "title")
 		assert.Equal(t, "title\n  - err", err.Error())
+		assert.Equal(t, "title", err.(*Error).Title())
+		assert.Equal(t, "", err.(*Error).Prefix())
 	})
 
 	t.Run("multi error", func(t *testing.T) {
@@ -59,6 +61,12 @@ func Test_Prefixed(t *testing.T) {
 		orig := errors.New("err")
 		err := Prefixed(orig, "prefix: ")
 		assert.Equal(t, "prefix: err", err.Error())
+		assert.Equal(t, "prefix: ", err.(*Error).Prefix())
+		assert.Equal(t, "", err.(*Error).Title())
+
+		// replaces previous title
+		err = Prefixed(Titled(orig, "title"), "prefix: ")
+		assert.Equal(t, "", err.(*Error).Title())
 	})
 
 	t.Run("multi error", func(t *testing.T) {
@@ -101,6 +109,29 @@ func Test_Prefixedf(t *testing.T) {
 	assert.Equal(t, "formatted prefix 42: err", err.Error())
 }
 
+func TestError_Len(t *testing.T) {
+	var nilErr *Error
+	assert.Zero(t, nilErr.Len())
+
+	err := Append(errors.New("a"), Append(errors.New("b"), errors.New("c")))
+	assert.Equal(t, 2, err.(*Error).Len())
+
+	err = Merge(Bounded(&Error{}, 1, KeepFirst), err)
+	assert.Len(t, err.(*Error).Errors, 1)
+	assert.Equal(t, 2, err.(*Error).Len())
+}
+
+func TestError_Summary(t *testing.T) {
+	var nilErr *Error
+	assert.Equal(t, "0 errors occurred", nilErr.Summary())
+
+	err := Append(nil, errors.New("a"))
+	assert.Equal(t, "1 error occurred", err.(*Error).Summary())
+
+	err = Titled(err, "title")
+	assert.Equal(t, "title", err.(*Error).Summary())
+}
+
 func Test_Append_appendSimpleError(t *testing.T) {
 	simpleErr := errors.New("err")
 	formatter := func([]error) string {
@@ -0,0 +1,218 @@
+package multierr
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// Severity describes how serious an error is.
+// Errors without an explicit severity are treated as SeverityError.
+// Errors below SeverityError (like warnings) do not fail an operation (see Error.Err).
+type Severity int
+
+// Supported severity levels, in ascending order.
+const (
+	SeverityDebug Severity = iota - 3
+	SeverityInfo
+	SeverityWarning
+	SeverityError // zero value
+	SeverityFatal
+)
+
+// String returns the lower-case name of the severity, like "warning".
+func (s Severity) String() string {
+	switch s {
+	case SeverityDebug:
+		return "debug"
+	case SeverityInfo:
+		return "info"
+	case SeverityWarning:
+		return "warning"
+	case SeverityError:
+		return "error"
+	case SeverityFatal:
+		return "fatal"
+	}
+	return "severity(" + strconv.Itoa(int(s)) + ")"
+}
+
+// severityError attaches a severity to an error.
+type severityError struct {
+	err      error
+	severity Severity
+}
+
+// Error implements the error interface.
+func (e *severityError) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap returns the underlying error.
+func (e *severityError) Unwrap() error {
+	return e.err
+}
+
+// Severity returns the attached severity.
+func (e *severityError) Severity() Severity {
+	return e.severity
+}
+
+// WithSeverity attaches the given severity to the error.
+// The error message is not modified. Returns nil if the error is nil.
+//
+//	err = multierr.Append(err, multierr.WithSeverity(errors.New("option 'x' is deprecated"), multierr.SeverityWarning))
+func WithSeverity(err error, severity Severity) error {
+	if err == nil {
+		return nil
+	}
+	return &severityError{
+		err:      err,
+		severity: severity,
+	}
+}
+
+// SeverityOf returns the severity of the error.
+// Errors (or any wrapping error in their chain) can provide a severity by implementing "Severity() Severity".
+// If multiple errors in the chain provide a severity, the outermost one is used.
+// Errors without severity are treated as SeverityError.
+//
+// The severity of a multi-error is the highest severity of all its (nested) sub-errors.
+// This also applies to wrapped and foreign multi-errors; severities of errors wrapping them are ignored.
+// Empty multi-errors have the severity SeverityDebug.
+func SeverityOf(err error) Severity {
+	return severityOf(err, nil, make(map[*Error]bool))
+}
+
+// severityOf returns the severity of err.
+// outer is the outermost error in the chain that provides a severity, or nil.
+// Visited multi-errors are skipped, as their severity was already taken into account.
+func severityOf(err error, outer interface{ Severity() Severity }, visited map[*Error]bool) Severity {
+	if s, ok := err.(interface{ Severity() Severity }); ok && outer == nil {
+		outer = s
+	}
+
+	var errs []error
+	switch e := err.(type) {
+	case *Error:
+		if e == nil || visited[e] {
+			return SeverityDebug
+		}
+		visited[e] = true
+		return highestSeverity(e.Errors, visited)
+	case interface{ Unwrap() []error }:
+		errs = e.Unwrap()
+	case interface{ Unwrap() error }:
+		if unwrapped := e.Unwrap(); unwrapped != nil {
+			return severityOf(unwrapped, outer, visited)
+		}
+	default:
+		errs, _ = split(err)
+	}
+	if len(errs) > 0 {
+		return highestSeverity(errs, visited)
+	}
+
+	if outer != nil {
+		return outer.Severity()
+	}
+	return SeverityError
+}
+
+// highestSeverity returns the highest severity of all errors, or SeverityDebug if there are none.
+func highestSeverity(errs []error, visited map[*Error]bool) Severity {
+	highest := SeverityDebug
+	for _, err := range errs {
+		if err == nil {
+			continue
+		}
+		if s := severityOf(err, nil, visited); s > highest {
+			highest = s
+		}
+	}
+	return highest
+}
+
+// HasErrors reports whether any (nested) sub-error has a severity of SeverityError or above.
+// Warnings and other sub-errors with lower severities are ignored.
+func (e *Error) HasErrors() bool {
+	return e != nil && SeverityOf(e) >= SeverityError
+}
+
+// Warnings returns all (nested) sub-errors with a severity below SeverityError,
+// i.e. all errors that do not fail the operation.
+func (e *Error) Warnings() []error {
+	var result []error
+	Walk(e, func(err error, _ int, _ []int) bool {
+		if _, ok := err.(*Error); ok {
+			return true
+		}
+		if SeverityOf(err) < SeverityError {
+			result = append(result, err)
+		}
+		return true
+	})
+	return result
+}
+
+// Err returns the error itself if it contains sub-errors with a severity of SeverityError or above.
+// Returns nil if there are only warnings (or other sub-errors with lower severities).
+//
+//	return multiErr.Err() // succeeds if only deprecations were reported
+func (e *Error) Err() error {
+	if !e.HasErrors() {
+		return nil
+	}
+	return e
+}
+
+// SeverityLabelFunc returns the label that is prepended to errors with the given severity.
+type SeverityLabelFunc func(Severity) string
+
+// SeverityLabel returns labels like "[warning] ".
+func SeverityLabel(s Severity) string {
+	return "[" + s.String() + "] "
+}
+
+// severityColors contains the ANSI color codes for each severity.
+var severityColors = map[Severity]string{
+	SeverityDebug:   "\x1b[90m",   // gray
+	SeverityInfo:    "\x1b[36m",   // cyan
+	SeverityWarning: "\x1b[33m",   // yellow
+	SeverityError:   "\x1b[31m",   // red
+	SeverityFatal:   "\x1b[1;31m", // bold red
+}
+
+// SeverityColorLabel returns labels like SeverityLabel, colorized with ANSI escape codes.
+func SeverityColorLabel(s Severity) string {
+	color, ok := severityColors[s]
+	if !ok {
+		return SeverityLabel(s)
+	}
+	return color + "[" + s.String() + "]\x1b[0m "
+}
+
+// SeverityFormatter returns a formatter func that labels each sub-error with its severity,
+// like "[warning] option 'x' is deprecated", before passing it to the given formatter.
+// Nested multi-errors are not labeled, as they are formatted by their own formatter.
+//
+// If formatter is nil, the current DefaultFormatter is used.
+// This allows using the result as DefaultFormatter.
+// If label is nil, SeverityLabel is used.
+func SeverityFormatter(formatter FormatterFunc, label SeverityLabelFunc) FormatterFunc {
+	formatter = resolveFormatter(formatter)
+	if label == nil {
+		label = SeverityLabel
+	}
+	return func(errs []error) string {
+		labeled := make([]error, len(errs))
+		for i, err := range errs {
+			switch err.(type) {
+			case *Error, *omittedErrors:
+				labeled[i] = err
+			default:
+				labeled[i] = fmt.Errorf("%s%w", label(SeverityOf(err)), err)
+			}
+		}
+		return formatter(labeled)
+	}
+}
@@ -0,0 +1,129 @@
+package multierr
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSeverity_String(t *testing.T) {
+	assert.Equal(t, "debug", SeverityDebug.String())
+	assert.Equal(t, "info", SeverityInfo.String())
+	assert.Equal(t, "warning", SeverityWarning.String())
+	assert.Equal(t, "error", SeverityError.String())
+	assert.Equal(t, "fatal", SeverityFatal.String())
+	assert.Equal(t, "severity(42)", Severity(42).String())
+}
+
+func TestWithSeverity(t *testing.T) {
+	assert.Nil(t, WithSeverity(nil, SeverityWarning))
+
+	err := WithSeverity(io.EOF, SeverityWarning)
+	assert.Equal(t, "EOF", err.Error())
+	assert.True(t, errors.Is(err, io.EOF))
+}
+
+type customSeverityErr struct{}
+
+func (customSeverityErr) Error() string      { return "custom" }
+func (customSeverityErr) Severity() Severity { return SeverityInfo }
+
+func TestSeverityOf(t *testing.T) {
+	assert.Equal(t, SeverityError, SeverityOf(io.EOF))
+	assert.Equal(t, SeverityWarning, SeverityOf(WithSeverity(io.EOF, SeverityWarning)))
+	assert.Equal(t, SeverityWarning, SeverityOf(fmt.Errorf("wrapped: %w", WithSeverity(io.EOF, SeverityWarning))))
+	assert.Equal(t, SeverityInfo, SeverityOf(customSeverityErr{}))
+
+	warnings := Append(nil, WithSeverity(io.EOF, SeverityWarning), customSeverityErr{})
+	assert.Equal(t, SeverityWarning, SeverityOf(warnings))
+	assert.Equal(t, SeverityFatal, SeverityOf(Append(nil, warnings, WithSeverity(io.EOF, SeverityFatal))))
+	assert.Equal(t, SeverityDebug, SeverityOf(&Error{}))
+}
+
+func TestSeverityOf_wrappedMultiError(t *testing.T) {
+	w := errors.New("deprecated")
+	err := Append(nil, fmt.Errorf("loading config: %w",
+		Append(nil, WithSeverity(w, SeverityWarning), errors.New("real failure"))),
+	).(*Error)
+
+	assert.Equal(t, SeverityError, SeverityOf(err))
+	assert.True(t, err.HasErrors())
+	assert.Same(t, err, err.Err())
+
+	// the severity of errors wrapping multi-errors is ignored
+	assert.Equal(t, SeverityError, SeverityOf(WithSeverity(Append(nil, io.EOF), SeverityWarning)))
+	assert.Equal(t, SeverityWarning, SeverityOf(&uberErr{errs: []error{WithSeverity(io.EOF, SeverityWarning)}}))
+}
+
+func TestError_HasErrors(t *testing.T) {
+	var nilErr *Error
+	assert.False(t, nilErr.HasErrors())
+	assert.False(t, (&Error{}).HasErrors())
+
+	err := Append(nil, WithSeverity(io.EOF, SeverityWarning)).(*Error)
+	assert.False(t, err.HasErrors())
+
+	err = Append(err, Append(nil, errors.New("nested"))).(*Error)
+	assert.True(t, err.HasErrors())
+}
+
+func TestError_Warnings(t *testing.T) {
+	var nilErr *Error
+	assert.Nil(t, nilErr.Warnings())
+
+	deprecated := WithSeverity(errors.New("deprecated"), SeverityWarning)
+	info := WithSeverity(errors.New("info"), SeverityInfo)
+	err := Append(nil, errors.New("a"), deprecated, Append(nil, info, errors.New("b"))).(*Error)
+	assert.Equal(t, []error{deprecated, info}, err.Warnings())
+}
+
+func TestError_Err(t *testing.T) {
+	var nilErr *Error
+	assert.Nil(t, nilErr.Err())
+
+	err := Append(nil, WithSeverity(io.EOF, SeverityWarning)).(*Error)
+	assert.Nil(t, err.Err())
+
+	err = Append(err, io.ErrUnexpectedEOF).(*Error)
+	assert.Same(t, err, err.Err())
+}
+
+func TestError_Err_cycle(t *testing.T) {
+	a, _ := manualCycle()
+	assert.Same(t, a, a.Err())
+}
+
+func TestSeverityFormatter(t *testing.T) {
+	DefaultFormatter = ListFormatterFunc
+
+	err := Append(nil,
+		errors.New("failed"),
+		WithSeverity(errors.New("deprecated"), SeverityWarning),
+		Append(nil, WithSeverity(errors.New("nested"), SeverityDebug)),
+	).(*Error)
+	err.Formatter = SeverityFormatter(nil, nil)
+	assert.Equal(t, "3 errors occurred:\n"+
+		"  - [error] failed\n"+
+		"  - [warning] deprecated\n"+
+		"  - 1 error occurred:\n"+
+		"      - nested", err.Error())
+
+	err.Formatter = SeverityFormatter(PrefixedListFormatter("> "), SeverityColorLabel)
+	assert.Equal(t, "> \x1b[31m[error]\x1b[0m failed\n"+
+		"> \x1b[33m[warning]\x1b[0m deprecated\n"+
+		"> 1 error occurred:\n"+
+		"    - nested", err.Error())
+
+	DefaultFormatter = SeverityFormatter(nil, nil)
+	defer func() { DefaultFormatter = ListFormatterFunc }()
+	assert.Equal(t, "1 error occurred:\n  - [warning] deprecated", Append(nil, WithSeverity(errors.New("deprecated"), SeverityWarning)).Error())
+}
+
+func TestSeverityColorLabel(t *testing.T) {
+	assert.Equal(t, "\x1b[90m[debug]\x1b[0m ", SeverityColorLabel(SeverityDebug))
+	assert.Equal(t, "\x1b[1;31m[fatal]\x1b[0m ", SeverityColorLabel(SeverityFatal))
+	assert.Equal(t, "[severity(9)] ", SeverityColorLabel(Severity(9)))
+}
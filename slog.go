@@ -0,0 +1,131 @@
+//go:build go1.21
+// +build go1.21
+
+package multierr
+
+import (
+	"context"
+	"log/slog"
+	"strconv"
+)
+
+// LogValue implements slog.LogValuer.
+// The error is logged as group containing the error count, the title (if set via Titled)
+// and an indexed list of all sub-errors. Nested multi-errors are logged recursively.
+// The count includes dropped errors (see Bounded), which are additionally logged as "dropped".
+//
+// Example (with a JSON handler):
+//
+//	{"count": 2, "errors": {"0": "missing name", "1": {"count": 1, "errors": {"0": "missing city"}}}}
+func (e *Error) LogValue() slog.Value {
+	if e == nil {
+		return slog.GroupValue(slog.Int("count", 0))
+	}
+	e = e.acyclic()
+
+	errs := make([]slog.Attr, len(e.Errors))
+	for i, err := range e.Errors {
+		key := strconv.Itoa(i)
+		if nested, ok := err.(*Error); ok {
+			errs[i] = slog.Attr{Key: key, Value: nested.LogValue()}
+		} else {
+			errs[i] = slog.String(key, err.Error())
+		}
+	}
+
+	attrs := make([]slog.Attr, 0, 4)
+	attrs = append(attrs, slog.Int("count", e.Len()))
+	if e.dropped > 0 {
+		attrs = append(attrs, slog.Int("dropped", e.dropped))
+	}
+	if e.title != "" {
+		attrs = append(attrs, slog.String("title", e.title))
+	}
+	attrs = append(attrs, slog.Attr{Key: "errors", Value: slog.GroupValue(errs...)})
+	return slog.GroupValue(attrs...)
+}
+
+// SplitHandler is a slog.Handler that splits records containing a multi-error
+// into one record per sub-error.
+//
+// The first record attribute holding a *Error is replaced by the sub-error.
+// Additionally, the attributes "error_index" and "error_count" are added.
+// Attributes added via slog.Logger.With and nested within groups are not split.
+type SplitHandler struct {
+	handler slog.Handler
+}
+
+// NewSplitHandler returns a SplitHandler that passes the split records to the given handler.
+func NewSplitHandler(handler slog.Handler) *SplitHandler {
+	return &SplitHandler{
+		handler: handler,
+	}
+}
+
+// Enabled implements slog.Handler.
+func (h *SplitHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.handler.Enabled(ctx, level)
+}
+
+// Handle implements slog.Handler.
+// Returns all errors of the underlying handler.
+func (h *SplitHandler) Handle(ctx context.Context, record slog.Record) error {
+	var attrs []slog.Attr
+	splitIdx := -1
+	var mErr *Error
+
+	record.Attrs(func(attr slog.Attr) bool {
+		if splitIdx < 0 {
+			if e, ok := multiErrorAttr(attr); ok {
+				splitIdx = len(attrs)
+				mErr = e
+			}
+		}
+		attrs = append(attrs, attr)
+		return true
+	})
+	if mErr == nil {
+		return h.handler.Handle(ctx, record)
+	}
+
+	var handlerErrs error
+	for i, err := range mErr.Errors {
+		split := slog.NewRecord(record.Time, record.Level, record.Message, record.PC)
+		split.AddAttrs(attrs[:splitIdx]...)
+		split.AddAttrs(
+			slog.Any(attrs[splitIdx].Key, err),
+			slog.Int("error_index", i),
+			slog.Int("error_count", len(mErr.Errors)),
+		)
+		split.AddAttrs(attrs[splitIdx+1:]...)
+		handlerErrs = Append(handlerErrs, h.handler.Handle(ctx, split))
+	}
+	return handlerErrs
+}
+
+func multiErrorAttr(attr slog.Attr) (*Error, bool) {
+	var val interface{}
+	switch attr.Value.Kind() {
+	case slog.KindAny:
+		val = attr.Value.Any()
+	case slog.KindLogValuer:
+		val = attr.Value.LogValuer()
+	default:
+		return nil, false
+	}
+	mErr, ok := val.(*Error)
+	if !ok || mErr == nil || len(mErr.Errors) == 0 {
+		return nil, false
+	}
+	return mErr, true
+}
+
+// WithAttrs implements slog.Handler.
+func (h *SplitHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return NewSplitHandler(h.handler.WithAttrs(attrs))
+}
+
+// WithGroup implements slog.Handler.
+func (h *SplitHandler) WithGroup(name string) slog.Handler {
+	return NewSplitHandler(h.handler.WithGroup(name))
+}
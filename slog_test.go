@@ -0,0 +1,141 @@
+//go:build go1.21
+// +build go1.21
+
+package multierr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func removeTime(groups []string, a slog.Attr) slog.Attr {
+	if a.Key == slog.TimeKey && len(groups) == 0 {
+		return slog.Attr{}
+	}
+	return a
+}
+
+func logLines(t *testing.T, buf *bytes.Buffer) []string {
+	t.Helper()
+	return strings.Split(strings.TrimSpace(buf.String()), "\n")
+}
+
+func TestError_LogValue(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{ReplaceAttr: removeTime}))
+
+	err := Titled(Append(
+		errors.New("missing name"),
+		Append(nil, errors.New("missing city"), errors.New("missing street")),
+	), "invalid input:")
+	logger.Error("validation failed", "err", err)
+
+	assert.JSONEq(t, `{
+		"level": "ERROR",
+		"msg": "validation failed",
+		"err": {
+			"count": 2,
+			"title": "invalid input:",
+			"errors": {
+				"0": "missing name",
+				"1": {
+					"count": 2,
+					"errors": {"0": "missing city", "1": "missing street"}
+				}
+			}
+		}
+	}`, buf.String())
+}
+
+func TestError_LogValue_bounded(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{ReplaceAttr: removeTime}))
+
+	err := Merge(Bounded(&Error{}, 2, KeepFirst), manyErrors(5))
+	logger.Error("import failed", "err", err)
+
+	assert.JSONEq(t, `{
+		"level": "ERROR",
+		"msg": "import failed",
+		"err": {
+			"count": 5,
+			"dropped": 3,
+			"errors": {"0": "err 0", "1": "err 1"}
+		}
+	}`, buf.String())
+}
+
+func TestError_LogValue_nil(t *testing.T) {
+	var typedNil *Error
+	assert.Equal(t, slog.KindGroup, typedNil.LogValue().Kind())
+}
+
+func TestSplitHandler(t *testing.T) {
+	var buf bytes.Buffer
+	handler := NewSplitHandler(slog.NewJSONHandler(&buf, &slog.HandlerOptions{ReplaceAttr: removeTime}))
+	logger := slog.New(handler).With("component", "importer")
+
+	err := Append(errors.New("a"), Append(nil, errors.New("b")))
+	logger.Warn("import failed", "file", "data.csv", "err", err, "rows", 3)
+
+	lines := logLines(t, &buf)
+	assert.Len(t, lines, 2)
+	assert.JSONEq(t, `{
+		"level": "WARN",
+		"msg": "import failed",
+		"component": "importer",
+		"file": "data.csv",
+		"err": "a",
+		"error_index": 0,
+		"error_count": 2,
+		"rows": 3
+	}`, lines[0])
+	assert.JSONEq(t, `{
+		"level": "WARN",
+		"msg": "import failed",
+		"component": "importer",
+		"file": "data.csv",
+		"err": {"count": 1, "errors": {"0": "b"}},
+		"error_index": 1,
+		"error_count": 2,
+		"rows": 3
+	}`, lines[1])
+}
+
+func TestSplitHandler_noMultiError(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(NewSplitHandler(slog.NewJSONHandler(&buf, &slog.HandlerOptions{ReplaceAttr: removeTime})))
+
+	logger.WithGroup("g").Info("msg", "err", errors.New("simple"), "empty", &Error{})
+
+	lines := logLines(t, &buf)
+	assert.Len(t, lines, 1)
+	var doc map[string]interface{}
+	assert.NoError(t, json.Unmarshal([]byte(lines[0]), &doc))
+	assert.Equal(t, "simple", doc["g"].(map[string]interface{})["err"])
+
+	assert.False(t, logger.Handler().Enabled(context.Background(), slog.LevelDebug))
+	assert.True(t, logger.Handler().Enabled(context.Background(), slog.LevelInfo))
+}
+
+func TestError_LogValue_cycle(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{ReplaceAttr: removeTime}))
+
+	mErr := &Error{}
+	mErr.Errors = []error{errors.New("a"), mErr}
+	logger.Info("msg", "err", mErr)
+
+	assert.JSONEq(t, `{
+		"level": "INFO",
+		"msg": "msg",
+		"err": {"count": 2, "errors": {"0": "a", "1": "<cycle>"}}
+	}`, buf.String())
+}
@@ -0,0 +1,96 @@
+package multierr
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+)
+
+// CaptureStacks enables capturing the caller's stack trace for every error
+// that is added via Append, Merge, MergePrefixed and similar functions.
+// Use WithStacks to enable stack traces for individual errors instead.
+//
+// Stack traces are printed when formatting errors with "%+v".
+// Capturing stack traces is expensive and therefore disabled by default.
+var CaptureStacks = false
+
+const maxStackDepth = 32
+
+// WithStacks enables capturing stack traces for all errors that are added to err in the future.
+// See CaptureStacks for more information.
+//
+// If the error is not a multierr.Error, it will be converted.
+// Returns nil if the error is nil. Otherwise, the result is always an *Error.
+func WithStacks(err error) error {
+	mErr := toError(err)
+	if mErr == nil {
+		return nil
+	}
+	mErr.captureStacks = true
+	return mErr
+}
+
+// add appends the given errors.
+// If enabled, the stack trace of the caller is recorded.
+// The capacity of bounded errors is respected (see Bounded).
+//
+// Must only be called by combine, which must be called directly by the exported functions.
+// Otherwise, the recorded stack traces contain additional frames of this package.
+func (e *Error) add(errs ...error) {
+	if !CaptureStacks && !e.captureStacks && e.stacks == nil && e.capacity <= 0 {
+		e.Errors = append(e.Errors, errs...)
+		return
+	}
+
+	var stack []uintptr
+	if CaptureStacks || e.captureStacks {
+		pcs := make([]uintptr, maxStackDepth)
+		// skip runtime.Callers, add, combine and the exported function
+		n := runtime.Callers(4, pcs)
+		stack = pcs[:n]
+	}
+	if stack != nil || e.stacks != nil {
+		// errors might have been appended without tracking (manually, or before enabling stacks)
+		for len(e.stacks) < len(e.Errors) {
+			e.stacks = append(e.stacks, nil)
+		}
+	}
+	for _, err := range errs {
+		e.store(err, stack)
+	}
+}
+
+// StackTrace returns the stack trace of the sub-error with the given index.
+// The first frame is the caller that added the error.
+// Returns nil if no stack trace was captured.
+//
+// Stack traces are tracked by index.
+// They are lost if the error slice is modified directly.
+func (e *Error) StackTrace(idx int) []runtime.Frame {
+	if e == nil || idx < 0 || idx >= len(e.stacks) || len(e.stacks) > len(e.Errors) {
+		return nil
+	}
+	pcs := e.stacks[idx]
+	if len(pcs) == 0 {
+		return nil
+	}
+
+	var result []runtime.Frame
+	frames := runtime.CallersFrames(pcs)
+	for {
+		frame, more := frames.Next()
+		result = append(result, frame)
+		if !more {
+			break
+		}
+	}
+	return result
+}
+
+// writeStack writes the stack trace of the sub-error with the given index.
+// Each frame is written into two indented lines.
+func (e *Error) writeStack(sb *strings.Builder, idx int) {
+	for _, frame := range e.StackTrace(idx) {
+		fmt.Fprintf(sb, "\n\t%s\n\t\t%s:%d", frame.Function, frame.File, frame.Line)
+	}
+}
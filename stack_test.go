@@ -0,0 +1,106 @@
+package multierr
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func withCaptureStacks(t *testing.T) {
+	CaptureStacks = true
+	t.Cleanup(func() {
+		CaptureStacks = false
+	})
+}
+
+func assertCaller(t *testing.T, err error, idx int, function string) {
+	t.Helper()
+	frames := err.(*Error).StackTrace(idx)
+	if assert.NotEmpty(t, frames) {
+		assert.True(t, strings.HasSuffix(frames[0].Function, function), "unexpected caller %q", frames[0].Function)
+		assert.True(t, strings.HasSuffix(frames[0].File, "stack_test.go"))
+	}
+}
+
+func TestStackTrace_disabled(t *testing.T) {
+	err := Append(errors.New("a"), errors.New("b"))
+	assert.Nil(t, err.(*Error).StackTrace(0))
+	assert.Nil(t, err.(*Error).stacks)
+
+	mErr := &Error{Errors: make([]error, 0, 10)}
+	simpleErr := errors.New("err")
+	allocs := testing.AllocsPerRun(5, func() {
+		_ = Append(mErr, simpleErr)
+	})
+	assert.Zero(t, allocs)
+}
+
+func TestCaptureStacks(t *testing.T) {
+	withCaptureStacks(t)
+	a, b := errors.New("a"), errors.New("b")
+
+	err := Append(a, b)
+	assertCaller(t, err, 0, "TestCaptureStacks")
+	assertCaller(t, err, 1, "TestCaptureStacks")
+
+	err = Merge(nil, Append(a, b))
+	assertCaller(t, err, 1, "TestCaptureStacks")
+
+	err = MergePrefixed(nil, "prefix: ", a)
+	assertCaller(t, err, 0, "TestCaptureStacks")
+
+	err = MergeField(nil, "field", a)
+	assertCaller(t, err, 0, "TestCaptureStacks")
+
+	immutableErr := Immutable.Append(err, b)
+	assertCaller(t, immutableErr, 1, "TestCaptureStacks")
+
+	var c Collector
+	c.Add(a)
+	assertCaller(t, c.Err(), 0, "TestCaptureStacks")
+
+	assert.Nil(t, err.(*Error).StackTrace(-1))
+	assert.Nil(t, err.(*Error).StackTrace(1))
+}
+
+func TestWithStacks(t *testing.T) {
+	a := errors.New("a")
+	assert.Nil(t, WithStacks(nil))
+
+	err := Append(nil, a)
+	err = WithStacks(err)
+	err = Append(err, a)
+	assert.Nil(t, err.(*Error).StackTrace(0))
+	assertCaller(t, err, 1, "TestWithStacks")
+
+	// errors that were added manually don't have stack traces
+	err.(*Error).Errors = append(err.(*Error).Errors, a)
+	err = Append(err, a)
+	assert.Nil(t, err.(*Error).StackTrace(2))
+	assertCaller(t, err, 3, "TestWithStacks")
+
+	// other errors are converted
+	err = WithStacks(a)
+	assert.Equal(t, []error{a}, Inspect(err))
+}
+
+func TestError_Format_stacks(t *testing.T) {
+	DefaultFormatter = ListFormatterFunc
+	withCaptureStacks(t)
+
+	nested := Append(nil, errors.New("b\nsecond line"))
+	err := Append(errors.New("a"), nested)
+
+	assert.Equal(t, err.Error(), fmt.Sprintf("%v", err))
+	assert.Equal(t, err.Error(), fmt.Sprintf("%s", err))
+
+	out := fmt.Sprintf("%+v", err)
+	assert.True(t, strings.HasPrefix(out, err.Error()+"\n\n[0] a\n\t"))
+	assert.Contains(t, out, "\n[1] 1 error occurred:\n\t")
+	assert.Contains(t, out, "\n[1.0] b\n\t")
+	assert.Contains(t, out, "TestError_Format_stacks\n\t\t")
+	assert.Contains(t, out, "stack_test.go:")
+}
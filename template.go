@@ -0,0 +1,111 @@
+package multierr
+
+import (
+	"strings"
+	"text/template"
+)
+
+// TemplateData is the data model that is passed to the templates of TemplateFormatter.
+// The root node describes the multi-error itself, Children describe the sub-errors.
+// Nested multi-errors contain their own sub-errors as Children.
+type TemplateData struct {
+	// Index is the position within the parent's children. It is 0 for the root.
+	Index int
+	// Title is the title of multi-errors (see Titled), or a generic "n errors occurred:" if there is none.
+	// The root always has the generic title, as formatters don't know the error's title.
+	// Empty for other errors.
+	Title string
+	// Count is the number of sub-errors of multi-errors, including truncated and dropped ones.
+	// Zero for other errors.
+	Count int
+	// Message is the error message.
+	// For field errors, the message does not contain the field path. Empty for the root.
+	Message string
+	// Field is the path of the invalid field, like "items[3].name".
+	// It is only set for errors of type *FieldError.
+	Field string
+	// Severity is the severity of the error (see SeverityOf).
+	Severity Severity
+	// Children contains all sub-errors of multi-errors.
+	Children []TemplateData
+	// Err is the underlying error. It is nil for the root.
+	Err error
+}
+
+// templateFuncs are available within all templates of TemplateFormatter.
+var templateFuncs = template.FuncMap{
+	// indent indents all lines except the first one by the given number of spaces
+	"indent": func(spaces int, s string) string {
+		return strings.Replace(s, "\n", "\n"+strings.Repeat(" ", spaces), -1)
+	},
+}
+
+// TemplateFormatter returns a formatter func that renders errors with the given text/template.
+// The template is executed with a TemplateData, which allows layouts to be defined in config files:
+//
+//	{{.Title}}{{range .Children}}
+//	  {{.Index}}. {{if .Field}}{{.Field}}: {{end}}{{indent 5 .Message}}{{end}}
+//
+// Nested multi-errors can be rendered recursively via named templates ("define" and "template").
+// The function "indent" indents all lines of a string (except the first one) by the given number of spaces.
+//
+// Returns an error if the template cannot be parsed.
+// If executing the template fails, the formatter falls back to ListFormatterFunc and appends the error.
+func TemplateFormatter(tmpl string) (FormatterFunc, error) {
+	t, err := template.New("multierr").Funcs(templateFuncs).Parse(tmpl)
+	if err != nil {
+		return nil, err
+	}
+	return templateFormatter(t), nil
+}
+
+// templateFormatter returns a formatter func that executes the parsed template with a TemplateData.
+func templateFormatter(t *template.Template) FormatterFunc {
+	return func(errs []error) string {
+		if len(errs) == 0 {
+			return "no errors occurred"
+		}
+		count := countErrors(errs)
+		data := TemplateData{
+			Title:    genericTitle(count),
+			Count:    count,
+			Severity: SeverityOf(&Error{Errors: errs}),
+			Children: templateChildren(errs),
+		}
+
+		var sb strings.Builder
+		if err := t.Execute(&sb, data); err != nil {
+			return ListFormatterFunc(errs) + "\n(template error: " + err.Error() + ")"
+		}
+		return sb.String()
+	}
+}
+
+func templateChildren(errs []error) []TemplateData {
+	result := make([]TemplateData, len(errs))
+	for i, err := range errs {
+		data := TemplateData{
+			Index:    i,
+			Message:  err.Error(),
+			Severity: SeverityOf(err),
+			Err:      err,
+		}
+		switch e := err.(type) {
+		case *FieldError:
+			if len(e.Path) > 0 {
+				data.Field = e.Path.String()
+				data.Message = e.Err.Error()
+			}
+		case *Error:
+			sub := e.visibleErrors()
+			data.Count = countErrors(sub)
+			data.Title = e.title
+			if data.Title == "" {
+				data.Title = genericTitle(data.Count)
+			}
+			data.Children = templateChildren(sub)
+		}
+		result[i] = data
+	}
+	return result
+}
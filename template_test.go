@@ -0,0 +1,105 @@
+package multierr
+
+import (
+	"errors"
+	"testing"
+	"text/template"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTemplateFormatter(t *testing.T) {
+	formatter, err := TemplateFormatter("{{.Title}}{{range .Children}}\n" +
+		"  {{.Index}}. {{if .Field}}{{.Field}}: {{end}}{{indent 5 .Message}} [{{.Severity}}]{{end}}")
+	assert.NoError(t, err)
+
+	assert.Equal(t, "no errors occurred", formatter(nil))
+
+	str := formatter([]error{
+		errors.New("a"),
+		&FieldError{Path: FieldPath{{Name: "name"}}, Err: errors.New("missing")},
+		WithSeverity(errors.New("multi\nline"), SeverityWarning),
+	})
+	assert.Equal(t, "3 errors occurred:\n"+
+		"  0. a [error]\n"+
+		"  1. name: missing [error]\n"+
+		"  2. multi\n"+
+		"     line [warning]", str)
+}
+
+func TestTemplateFormatter_nested(t *testing.T) {
+	formatter, err := TemplateFormatter(`{{define "list"}}{{range .}}
+{{.Index}}:{{if .Children}}{{.Title}} ({{.Count}}){{template "list" .Children}}{{else}}{{.Message}}{{end}}{{end}}{{end}}` +
+		`{{.Count}}{{template "list" .Children}}`)
+	assert.NoError(t, err)
+
+	str := formatter([]error{
+		errors.New("a"),
+		Titled(Append(nil, errors.New("b"), Append(nil, errors.New("c"))), "nested"),
+	})
+	assert.Equal(t, "2\n"+
+		"0:a\n"+
+		"1:nested (2)\n"+
+		"0:b\n"+
+		"1:1 error occurred: (1)\n"+
+		"0:c", str)
+}
+
+func TestTemplateFormatter_data(t *testing.T) {
+	// capture the data model via a custom function
+	var data TemplateData
+	tmpl, err := template.New("test").Funcs(template.FuncMap{
+		"capture": func(d TemplateData) string {
+			data = d
+			return ""
+		},
+	}).Parse("{{capture .}}")
+	assert.NoError(t, err)
+	formatter := templateFormatter(tmpl)
+
+	errA := WithSeverity(errors.New("a"), SeverityInfo)
+	nested := Titled(Append(nil, errA), "nested")
+	assert.Equal(t, "", formatter([]error{nested}))
+	assert.Equal(t, TemplateData{
+		Title:    "1 error occurred:",
+		Count:    1,
+		Severity: SeverityInfo,
+		Children: []TemplateData{{
+			Title:    "nested",
+			Count:    1,
+			Message:  nested.Error(),
+			Severity: SeverityInfo,
+			Err:      nested,
+			Children: []TemplateData{{
+				Message:  "a",
+				Severity: SeverityInfo,
+				Err:      errA,
+			}},
+		}},
+	}, data)
+}
+
+func TestTemplateFormatter_truncated(t *testing.T) {
+	formatter, err := TemplateFormatter("{{.Count}}:{{range .Children}} {{.Message}}{{end}}")
+	assert.NoError(t, err)
+
+	str := TruncatedFormatter(formatter, 1, 0)([]error{errors.New("a"), errors.New("b"), errors.New("c")})
+	assert.Equal(t, "3: a ... and 2 more errors", str)
+}
+
+func TestTemplateFormatter_parseError(t *testing.T) {
+	formatter, err := TemplateFormatter("{{.Title")
+	assert.Error(t, err)
+	assert.Nil(t, formatter)
+
+	_, err = TemplateFormatter("{{unknown .}}")
+	assert.Error(t, err)
+}
+
+func TestTemplateFormatter_executionError(t *testing.T) {
+	formatter, err := TemplateFormatter("{{.Unknown}}")
+	assert.NoError(t, err)
+
+	str := formatter([]error{errors.New("a")})
+	assert.Contains(t, str, "1 error occurred:\n  - a\n(template error: ")
+}
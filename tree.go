@@ -0,0 +1,104 @@
+package multierr
+
+import (
+	"fmt"
+	"strings"
+)
+
+// TreeOptions configures a TreeFormatter.
+type TreeOptions struct {
+	// Title is printed above the tree.
+	// Defaults to a generic "n errors occurred:".
+	Title string
+	// MaxDepth limits the number of printed levels.
+	// Deeper nested multi-errors are collapsed into "(+n more nested errors)".
+	// Zero means unlimited.
+	MaxDepth int
+	// ASCII uses ASCII characters instead of box-drawing characters.
+	ASCII bool
+}
+
+type treeConnectors struct {
+	branch, last, pipe, space string
+}
+
+var (
+	boxConnectors   = treeConnectors{branch: "├─ ", last: "└─ ", pipe: "│  ", space: "   "}
+	asciiConnectors = treeConnectors{branch: "|- ", last: "`- ", pipe: "|  ", space: "   "}
+)
+
+// TreeFormatter returns a formatter func that draws all (nested) sub-errors as a tree.
+// In contrast to ListFormatterFunc, nested multi-errors are walked structurally.
+// Their titles (see Titled) are used as node labels.
+//
+// Example:
+//
+//	invalid input:
+//	├─ missing name
+//	└─ invalid address:
+//	   ├─ missing city
+//	   └─ missing street
+func TreeFormatter(opts TreeOptions) FormatterFunc {
+	connectors := boxConnectors
+	if opts.ASCII {
+		connectors = asciiConnectors
+	}
+	return func(errs []error) string {
+		if len(errs) == 0 {
+			return "no errors occurred"
+		}
+		title := opts.Title
+		if title == "" {
+			title = genericTitle(countErrors(errs))
+		}
+
+		var sb strings.Builder
+		sb.WriteString(title)
+		writeTree(&sb, errs, "", 1, opts.MaxDepth, connectors)
+		return sb.String()
+	}
+}
+
+func writeTree(sb *strings.Builder, errs []error, indent string, depth, maxDepth int, c treeConnectors) {
+	for i, err := range errs {
+		connector, childIndent := c.branch, indent+c.pipe
+		if i == len(errs)-1 {
+			connector, childIndent = c.last, indent+c.space
+		}
+		sb.WriteString("\n" + indent + connector)
+
+		nested, ok := err.(*Error)
+		if !ok || nested == nil {
+			sb.WriteString(strings.Replace(err.Error(), "\n", "\n"+childIndent, -1))
+			continue
+		}
+
+		sub := nested.visibleErrors()
+		label := nested.title
+		if label == "" {
+			label = genericTitle(countErrors(sub))
+		}
+		sb.WriteString(label)
+		if maxDepth > 0 && depth >= maxDepth {
+			if n := countNested(nested); n > 0 {
+				fmt.Fprintf(sb, " (+%d more nested errors)", n)
+			}
+			continue
+		}
+		writeTree(sb, sub, childIndent, depth+1, maxDepth, c)
+	}
+}
+
+// countNested returns the number of all (recursively) contained errors that are not multi-errors,
+// including dropped ones (see Bounded).
+func countNested(e *Error) int {
+	count := e.dropped
+	for _, err := range e.Errors {
+		if nested, ok := err.(*Error); ok && nested != nil {
+			count += countNested(nested)
+		} else {
+			count++
+		}
+	}
+	return count
+}
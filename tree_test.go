@@ -0,0 +1,83 @@
+package multierr
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func treeTestError() error {
+	return Append(
+		errors.New("missing name"),
+		Titled(Append(
+			errors.New("missing city"),
+			Append(nil, errors.New("too long"), errors.New("invalid\ncharacters")),
+		), "invalid address:"),
+		errors.New("too\nyoung"),
+	)
+}
+
+func TestTreeFormatter(t *testing.T) {
+	err := treeTestError().(*Error)
+	err.Formatter = TreeFormatter(TreeOptions{})
+
+	expected := "3 errors occurred:\n" +
+		"├─ missing name\n" +
+		"├─ invalid address:\n" +
+		"│  ├─ missing city\n" +
+		"│  └─ 2 errors occurred:\n" +
+		"│     ├─ too long\n" +
+		"│     └─ invalid\n" +
+		"│        characters\n" +
+		"└─ too\n" +
+		"   young"
+	assert.Equal(t, expected, err.Error())
+}
+
+func TestTreeFormatter_ASCII(t *testing.T) {
+	err := treeTestError().(*Error)
+	err.Formatter = TreeFormatter(TreeOptions{
+		Title: "invalid input:",
+		ASCII: true,
+	})
+
+	expected := "invalid input:\n" +
+		"|- missing name\n" +
+		"|- invalid address:\n" +
+		"|  |- missing city\n" +
+		"|  `- 2 errors occurred:\n" +
+		"|     |- too long\n" +
+		"|     `- invalid\n" +
+		"|        characters\n" +
+		"`- too\n" +
+		"   young"
+	assert.Equal(t, expected, err.Error())
+}
+
+func TestTreeFormatter_MaxDepth(t *testing.T) {
+	err := treeTestError().(*Error)
+
+	err.Formatter = TreeFormatter(TreeOptions{MaxDepth: 1})
+	expected := "3 errors occurred:\n" +
+		"├─ missing name\n" +
+		"├─ invalid address: (+3 more nested errors)\n" +
+		"└─ too\n" +
+		"   young"
+	assert.Equal(t, expected, err.Error())
+
+	err.Formatter = TreeFormatter(TreeOptions{MaxDepth: 2})
+	expected = "3 errors occurred:\n" +
+		"├─ missing name\n" +
+		"├─ invalid address:\n" +
+		"│  ├─ missing city\n" +
+		"│  └─ 2 errors occurred: (+2 more nested errors)\n" +
+		"└─ too\n" +
+		"   young"
+	assert.Equal(t, expected, err.Error())
+}
+
+func TestTreeFormatter_noError(t *testing.T) {
+	err := &Error{Formatter: TreeFormatter(TreeOptions{})}
+	assert.Equal(t, "no errors occurred", err.Error())
+}
@@ -0,0 +1,198 @@
+package multierr
+
+import (
+	"strconv"
+)
+
+// TruncateLimits limits the number of sub-errors that are passed to formatters.
+// If an error has more than First+Last sub-errors, only the first and last ones are formatted.
+// The omitted ones are replaced by a single "... and n more errors" entry.
+//
+// The zero value disables truncation.
+type TruncateLimits struct {
+	First int
+	Last  int
+}
+
+// TruncatedFormatter returns a formatter func that only passes the first and last sub-errors to the given formatter.
+// The omitted ones are replaced by a single "... and n more errors" entry.
+// Passing zero for both disables truncation.
+//
+// If formatter is nil, the current DefaultFormatter is used.
+// This allows using the result as DefaultFormatter.
+func TruncatedFormatter(formatter FormatterFunc, first, last int) FormatterFunc {
+	formatter = resolveFormatter(formatter)
+	limits := TruncateLimits{
+		First: first,
+		Last:  last,
+	}
+	return func(errs []error) string {
+		return formatter(limits.apply(errs))
+	}
+}
+
+// Truncated wraps the error's formatter with a TruncatedFormatter.
+// Only the first and last sub-errors are printed when calling Error.Error().
+// Inspect, Unwrap etc. still return all sub-errors.
+//
+// The limits are also respected by formatters that render nested multi-errors themselves, like TreeFormatter.
+// Titles and prefixes are kept. Call Truncated after Titled or Prefixed, as they replace the formatter
+// and therefore remove the truncation.
+//
+// If the error is not a multierr.Error, it will be converted.
+// Returns nil if the error is nil. Otherwise, the result is always an *Error.
+func Truncated(err error, first, last int) error {
+	mErr := toError(err)
+	if mErr == nil {
+		return nil
+	}
+	formatter := TruncatedFormatter(mErr.Formatter, first, last)
+	if mErr.hasStockFormatter() {
+		mErr.setStockFormatter(formatter)
+	} else {
+		mErr.Formatter = formatter
+	}
+	mErr.truncate = &TruncateLimits{
+		First: first,
+		Last:  last,
+	}
+	return mErr
+}
+
+// visibleErrors returns the sub-errors that are shown if the error is rendered as nested multi-error
+// by formatters that don't call Error.Error(), like TreeFormatter.
+// Dropped errors are replaced by a placeholder, and the limits of Truncated are applied.
+func (e *Error) visibleErrors() []error {
+	errs := e.formattedErrors()
+	if e.truncate == nil {
+		return errs
+	}
+	return e.truncate.apply(errs)
+}
+
+// formattedErrors returns the sub-errors that are passed to the formatter.
+// Dropped errors are replaced by a placeholder (see Bounded).
+func (e *Error) formattedErrors() []error {
+	if e.dropped == 0 {
+		return e.Errors
+	}
+
+	dropped := &omittedErrors{count: e.dropped, dropped: true}
+	result := make([]error, 0, len(e.Errors)+1)
+	if e.overflow == KeepLast {
+		result = append(result, dropped)
+		return append(result, e.Errors...)
+	}
+	result = append(result, e.Errors...)
+	return append(result, dropped)
+}
+
+// apply replaces the errors between the first and last ones by a placeholder.
+// Placeholders for dropped errors (see Bounded) are kept.
+func (l TruncateLimits) apply(errs []error) []error {
+	if l.First < 0 {
+		l.First = 0
+	}
+	if l.Last < 0 {
+		l.Last = 0
+	}
+	shown := l.First + l.Last
+	if shown == 0 {
+		return errs
+	}
+
+	var head, tail []error
+	if len(errs) > 0 && isDropped(errs[0]) {
+		head, errs = errs[:1], errs[1:]
+	}
+	if len(errs) > 0 && isDropped(errs[len(errs)-1]) {
+		tail, errs = errs[len(errs)-1:], errs[:len(errs)-1]
+	}
+	if len(errs) <= shown {
+		if head == nil && tail == nil {
+			return errs
+		}
+		return append(append(append([]error{}, head...), errs...), tail...)
+	}
+
+	hidden := errs[l.First : len(errs)-l.Last]
+	result := make([]error, 0, shown+3)
+	result = append(result, head...)
+	result = append(result, errs[:l.First]...)
+	result = append(result, &omittedErrors{
+		count:  countErrors(hidden),
+		hidden: hidden,
+		limits: l,
+	})
+	result = append(result, errs[len(errs)-l.Last:]...)
+	return append(result, tail...)
+}
+
+// untruncate restores the errors that were replaced by a placeholder via TruncateLimits.apply.
+// Returns the limits that were applied, or nil if the errors were not truncated.
+func untruncate(errs []error) ([]error, *TruncateLimits) {
+	for i, err := range errs {
+		omitted, ok := err.(*omittedErrors)
+		if !ok || omitted.dropped {
+			continue
+		}
+		result := make([]error, 0, len(errs)-1+len(omitted.hidden))
+		result = append(result, errs[:i]...)
+		result = append(result, omitted.hidden...)
+		result = append(result, errs[i+1:]...)
+		return result, &omitted.limits
+	}
+	return errs, nil
+}
+
+func isDropped(err error) bool {
+	omitted, ok := err.(*omittedErrors)
+	return ok && omitted.dropped
+}
+
+// omittedErrors is passed to formatters as placeholder for multiple errors that are not shown.
+type omittedErrors struct {
+	count   int
+	dropped bool // errors were not stored at all
+
+	hidden []error        // the omitted errors, unless they were dropped
+	limits TruncateLimits // the limits that caused omitting the errors
+}
+
+// Error implements the error interface.
+func (e *omittedErrors) Error() string {
+	msg := "... and " + formatCount(e.count) + " more errors"
+	if e.count == 1 {
+		msg = "... and 1 more error"
+	}
+	if e.dropped {
+		msg += " (dropped)"
+	}
+	return msg
+}
+
+// countErrors returns the number of errors, including the ones that were omitted or deduplicated.
+func countErrors(errs []error) int {
+	count := len(errs)
+	for _, err := range errs {
+		switch e := err.(type) {
+		case *omittedErrors:
+			count += e.count - 1
+		case *repeatedError:
+			count += e.count - 1
+		}
+	}
+	return count
+}
+
+// formatCount formats a number with thousands separators, like "4,812".
+func formatCount(n int) string {
+	if n < 0 {
+		return "-" + formatCount(-n)
+	}
+	str := strconv.Itoa(n)
+	for i := len(str) - 3; i > 0; i -= 3 {
+		str = str[:i] + "," + str[i:]
+	}
+	return str
+}
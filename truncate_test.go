@@ -0,0 +1,123 @@
+package multierr
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func manyErrors(n int) error {
+	var err error
+	for i := 0; i < n; i++ {
+		err = Append(err, fmt.Errorf("err %d", i))
+	}
+	return err
+}
+
+func TestTruncated(t *testing.T) {
+	DefaultFormatter = ListFormatterFunc
+
+	err := Truncated(manyErrors(4815), 2, 1)
+	assert.Equal(t, "4815 errors occurred:\n"+
+		"  - err 0\n"+
+		"  - err 1\n"+
+		"  - ... and 4,812 more errors\n"+
+		"  - err 4814", err.Error())
+
+	// all errors are still accessible
+	assert.Len(t, Inspect(err), 4815)
+
+	err = Truncated(manyErrors(4), 3, 0)
+	assert.Equal(t, "4 errors occurred:\n"+
+		"  - err 0\n"+
+		"  - err 1\n"+
+		"  - err 2\n"+
+		"  - ... and 1 more error", err.Error())
+
+	err = Truncated(manyErrors(3), 3, 0)
+	assert.Equal(t, "3 errors occurred:\n"+
+		"  - err 0\n"+
+		"  - err 1\n"+
+		"  - err 2", err.Error())
+
+	err = Truncated(manyErrors(3), 0, 1)
+	assert.Equal(t, "3 errors occurred:\n"+
+		"  - ... and 2 more errors\n"+
+		"  - err 2", err.Error())
+}
+
+func TestTruncated_customFormatter(t *testing.T) {
+	err := Truncated(Titled(manyErrors(5), "title"), 1, 0)
+	assert.Equal(t, "title\n"+
+		"  - err 0\n"+
+		"  - ... and 4 more errors", err.Error())
+	assert.Equal(t, "title", err.(*Error).Title())
+
+	err = Truncated(Prefixed(manyErrors(5), "prefix: "), 1, 0)
+	assert.Equal(t, "prefix: err 0\n"+
+		"prefix: ... and 4 more errors", err.Error())
+
+	// replacing the formatter removes the truncation
+	err = Prefixed(err, "prefix: ")
+	assert.Len(t, strings.Split(err.Error(), "\n"), 5)
+	assert.Len(t, err.(*Error).visibleErrors(), 5)
+}
+
+func TestTruncated_conversion(t *testing.T) {
+	assert.Nil(t, Truncated(nil, 1, 1))
+
+	simpleErr := errors.New("err")
+	err := Truncated(simpleErr, 1, 1)
+	assert.Equal(t, []error{simpleErr}, Inspect(err))
+}
+
+func TestTruncated_nested(t *testing.T) {
+	err := Append(errors.New("a"), Truncated(manyErrors(5), 1, 0))
+	err.(*Error).Formatter = TreeFormatter(TreeOptions{ASCII: true})
+	assert.Equal(t, "2 errors occurred:\n"+
+		"|- a\n"+
+		"`- 5 errors occurred:\n"+
+		"   |- err 0\n"+
+		"   `- ... and 4 more errors", err.Error())
+}
+
+func TestTruncatedFormatter(t *testing.T) {
+	errs := Inspect(manyErrors(5))
+	formatter := TruncatedFormatter(PrefixedListFormatter("> "), 1, 1)
+	assert.Equal(t, "> err 0\n"+
+		"> ... and 3 more errors\n"+
+		"> err 4", formatter(errs))
+
+	formatter = TruncatedFormatter(PrefixedListFormatter("> "), 0, 0)
+	assert.Len(t, strings.Split(formatter(errs), "\n"), 5)
+}
+
+func TestTruncatedFormatter_default(t *testing.T) {
+	DefaultFormatter = ListFormatterFunc
+	DefaultFormatter = TruncatedFormatter(nil, 1, 1)
+	defer func() {
+		DefaultFormatter = ListFormatterFunc
+	}()
+
+	err := manyErrors(5)
+	assert.Equal(t, "5 errors occurred:\n"+
+		"  - err 0\n"+
+		"  - ... and 3 more errors\n"+
+		"  - err 4", err.Error())
+
+	// custom formatters are not truncated
+	err = Prefixed(err, "prefix: ")
+	assert.Len(t, strings.Split(err.Error(), "\n"), 5)
+}
+
+func TestFormatCount(t *testing.T) {
+	assert.Equal(t, "0", formatCount(0))
+	assert.Equal(t, "999", formatCount(999))
+	assert.Equal(t, "1,000", formatCount(1000))
+	assert.Equal(t, "4,812", formatCount(4812))
+	assert.Equal(t, "1,234,567", formatCount(1234567))
+	assert.Equal(t, "-12,345", formatCount(-12345))
+}
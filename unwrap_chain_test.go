@@ -0,0 +1,49 @@
+//go:build !go1.20
+// +build !go1.20
+
+package multierr
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestError_Unwrap(t *testing.T) {
+	mErr3 := Append(
+		errors.New("c1"),
+	)
+	mErr2 := Append(
+		errors.New("b1"),
+		errors.New("b2"),
+		mErr3,
+	)
+	mErr1 := Append(
+		errors.New("a1"),
+		errors.New("a2"),
+		mErr2,
+		errors.New("a3"),
+	)
+
+	err := errors.Unwrap(mErr1)
+	assert.EqualError(t, err, "a1")
+
+	err = errors.Unwrap(err)
+	assert.EqualError(t, err, "a2")
+
+	err = errors.Unwrap(err)
+	assert.EqualError(t, err, "b1")
+
+	err = errors.Unwrap(err)
+	assert.EqualError(t, err, "b2")
+
+	err = errors.Unwrap(err)
+	assert.EqualError(t, err, "c1")
+
+	err = errors.Unwrap(err)
+	assert.EqualError(t, err, "a3")
+
+	err = errors.Unwrap(err)
+	assert.Nil(t, err)
+}
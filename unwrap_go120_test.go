@@ -0,0 +1,94 @@
+//go:build go1.20
+// +build go1.20
+
+package multierr
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestError_Unwrap(t *testing.T) {
+	a1, a2 := errors.New("a1"), errors.New("a2")
+	mErr := Append(a1, a2)
+
+	assert.Equal(t, []error{a1, a2}, mErr.(*Error).Unwrap())
+	// multiple errors can't be unwrapped into a single one
+	assert.Nil(t, errors.Unwrap(mErr))
+}
+
+func TestError_Is_allBranches(t *testing.T) {
+	b1 := errors.New("b1")
+	c1 := errors.New("c1")
+
+	mErr3 := Append(c1)
+	mErr2 := Append(
+		b1,
+		fmt.Errorf("wrapped: %w", mErr3),
+	)
+	mErr1 := Append(
+		errors.New("a1"),
+		mErr2,
+		fmt.Errorf("wrapped: %w", io.EOF),
+		errors.Join(os.ErrNotExist, os.ErrClosed),
+	)
+
+	for _, target := range []error{b1, c1, io.EOF, os.ErrNotExist, os.ErrClosed, mErr2, mErr3} {
+		assert.True(t, errors.Is(mErr1, target), "target %q", target)
+	}
+	assert.False(t, errors.Is(mErr1, io.ErrUnexpectedEOF))
+	assert.False(t, errors.Is(mErr2, io.EOF))
+}
+
+type testErrA struct{ val string }
+
+func (t *testErrA) Error() string { return t.val }
+
+type testErrB struct{ val string }
+
+func (t *testErrB) Error() string { return t.val }
+
+func TestError_As_allBranches(t *testing.T) {
+	needleA := &testErrA{"a"}
+	needleB := &testErrB{"b"}
+
+	mErr := Append(
+		errors.New("x"),
+		Append(
+			errors.New("y"),
+			fmt.Errorf("wrapped: %w", Append(needleB)),
+		),
+		errors.Join(errors.New("z"), needleA),
+	)
+
+	var resA *testErrA
+	assert.True(t, errors.As(mErr, &resA))
+	assert.Same(t, needleA, resA)
+
+	var resB *testErrB
+	assert.True(t, errors.As(mErr, &resB))
+	assert.Same(t, needleB, resB)
+
+	var pathErr *os.PathError
+	assert.False(t, errors.As(mErr, &pathErr))
+}
+
+func TestError_As_firstMatchDepthFirst(t *testing.T) {
+	first := &testErrA{"first"}
+	second := &testErrA{"second"}
+
+	mErr := Append(
+		errors.New("x"),
+		Append(errors.New("y"), first),
+		second,
+	)
+
+	var res *testErrA
+	assert.True(t, errors.As(mErr, &res))
+	assert.Same(t, first, res)
+}
@@ -0,0 +1,127 @@
+package multierr
+
+// WalkFunc is called by Walk for every error in the tree.
+// depth is 0 for the error passed to Walk, 1 for its sub-errors, and so on.
+// path contains the indices of the sub-errors leading to err. It is empty for the error passed to Walk
+// and must not be retained after the function returns.
+// If the function returns false for a multi-error, its sub-errors are skipped.
+type WalkFunc func(err error, depth int, path []int) bool
+
+// Walk calls fn for err and all (recursively) contained sub-errors, depth-first.
+// Multi-errors are visited before their sub-errors.
+//
+// Only multi-errors of this package are walked into.
+// Wrapped errors and foreign multi-errors are passed to fn as they are (see Convert).
+// Cycles are skipped.
+func Walk(err error, fn WalkFunc) {
+	walk(err, fn, nil, nil)
+}
+
+func walk(err error, fn WalkFunc, path []int, visited []*Error) {
+	if err == nil {
+		return
+	}
+	mErr, ok := err.(*Error)
+	if ok && mErr != nil {
+		for _, v := range visited {
+			if v == mErr {
+				return
+			}
+		}
+		visited = append(visited, mErr)
+	}
+	if !fn(err, len(path), path[:len(path):len(path)]) || mErr == nil {
+		return
+	}
+	for i, sub := range mErr.Errors {
+		walk(sub, fn, append(path, i), visited)
+	}
+}
+
+// Filter returns a copy of err that only contains the sub-errors for which keep returns true.
+// Nested multi-errors are filtered recursively and removed if they end up empty.
+// Formatters, titles and other settings are preserved, dropped errors are not (see Map). err itself is never modified.
+// Returns nil if there are no remaining errors.
+//
+// If err is not a multi-error, it is returned if keep returns true.
+func Filter(err error, keep func(error) bool) error {
+	return Map(err, func(e error) error {
+		if keep(e) {
+			return e
+		}
+		return nil
+	})
+}
+
+// Map returns a copy of err where every sub-error is replaced by the result of fn.
+// Sub-errors for which fn returns nil are removed.
+// Nested multi-errors are mapped recursively and removed if they end up empty.
+// Formatters, titles and other settings (like the capacity of Bounded) are preserved. err itself is never modified.
+// The number of dropped errors is not preserved, as it is unknown which of them would remain.
+// Returns nil if there are no remaining errors.
+//
+// If err is not a multi-error, the result of fn(err) is returned.
+// Cycles are removed.
+func Map(err error, fn func(error) error) error {
+	return mapErrors(err, fn, nil)
+}
+
+func mapErrors(err error, fn func(error) error, visited []*Error) error {
+	if err == nil {
+		return nil
+	}
+	mErr, ok := err.(*Error)
+	if !ok {
+		return fn(err)
+	}
+	if mErr == nil {
+		return nil
+	}
+	for _, v := range visited {
+		if v == mErr {
+			return nil
+		}
+	}
+	visited = append(visited, mErr)
+
+	result := *mErr
+	result.Errors = make([]error, 0, len(mErr.Errors))
+	result.stacks = nil
+	result.dropped = 0
+	for i, sub := range mErr.Errors {
+		mapped := mapErrors(sub, fn, visited)
+		if mapped == nil {
+			continue
+		}
+		result.Errors = append(result.Errors, mapped)
+		if mErr.stacks != nil {
+			var stack []uintptr
+			if i < len(mErr.stacks) {
+				stack = mErr.stacks[i]
+			}
+			result.stacks = append(result.stacks, stack)
+		}
+	}
+	if len(result.Errors) == 0 {
+		return nil
+	}
+	return &result
+}
+
+// Partition splits err into two copies.
+// matched contains all sub-errors for which pred returns true, rest contains all others.
+// pred is called exactly once per sub-error. See Filter for more information.
+func Partition(err error, pred func(error) bool) (matched, rest error) {
+	var results []bool
+	matched = Filter(err, func(e error) bool {
+		m := pred(e)
+		results = append(results, m)
+		return m
+	})
+	i := 0
+	rest = Filter(err, func(error) bool {
+		i++
+		return !results[i-1]
+	})
+	return matched, rest
+}
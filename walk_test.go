@@ -0,0 +1,205 @@
+package multierr
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type walkEntry struct {
+	msg   string
+	depth int
+	path  []int
+}
+
+func treeForWalk() error {
+	nested := Titled(Append(nil, errors.New("b1"), io.EOF), "nested:")
+	return Append(errors.New("a"), nested, errors.New("c"))
+}
+
+func TestWalk(t *testing.T) {
+	DefaultFormatter = ListFormatterFunc
+	err := treeForWalk()
+
+	var entries []walkEntry
+	Walk(err, func(e error, depth int, path []int) bool {
+		msg := e.Error()
+		if _, ok := e.(*Error); ok {
+			msg = "*Error"
+		}
+		entries = append(entries, walkEntry{msg, depth, append([]int{}, path...)})
+		return true
+	})
+	assert.Equal(t, []walkEntry{
+		{"*Error", 0, []int{}},
+		{"a", 1, []int{0}},
+		{"*Error", 1, []int{1}},
+		{"b1", 2, []int{1, 0}},
+		{"EOF", 2, []int{1, 1}},
+		{"c", 1, []int{2}},
+	}, entries)
+}
+
+func TestWalk_skip(t *testing.T) {
+	err := treeForWalk()
+
+	count := 0
+	Walk(err, func(e error, depth int, path []int) bool {
+		count++
+		return depth == 0
+	})
+	assert.Equal(t, 4, count)
+
+	Walk(nil, func(error, int, []int) bool {
+		assert.Fail(t, "called for nil error")
+		return true
+	})
+}
+
+func TestWalk_cycle(t *testing.T) {
+	a, _ := manualCycle()
+
+	count := 0
+	Walk(a, func(error, int, []int) bool {
+		count++
+		return true
+	})
+	assert.Equal(t, 6, count) // a, a1, EOF, b, b1, field error
+}
+
+func TestFilter(t *testing.T) {
+	DefaultFormatter = ListFormatterFunc
+	err := treeForWalk()
+	before := err.Error()
+
+	filtered := Filter(err, func(e error) bool {
+		return e != io.EOF
+	})
+	assert.Equal(t, "3 errors occurred:\n"+
+		"  - a\n"+
+		"  - nested:\n"+
+		"      - b1\n"+
+		"  - c", filtered.Error())
+	assert.Equal(t, "nested:", filtered.(*Error).Errors[1].(*Error).Title())
+	assert.Equal(t, before, err.Error(), "original must not be modified")
+
+	filtered = Filter(err, func(e error) bool {
+		return e.Error() != "b1" && e != io.EOF
+	})
+	assert.Len(t, Inspect(filtered), 2, "empty nested errors must be removed")
+
+	assert.Nil(t, Filter(err, func(error) bool { return false }))
+	assert.Nil(t, Filter(nil, func(error) bool { return true }))
+	assert.Nil(t, Filter(io.EOF, func(error) bool { return false }))
+	assert.Equal(t, io.EOF, Filter(io.EOF, func(error) bool { return true }))
+}
+
+func TestFilter_preservesSettings(t *testing.T) {
+	DefaultFormatter = ListFormatterFunc
+	err := Bounded(Prefixed(Append(nil, errors.New("a"), io.EOF), "prefix: "), 5, KeepLast)
+
+	filtered := Filter(err, func(e error) bool { return e != io.EOF })
+	assert.Equal(t, "prefix: a", filtered.Error())
+	assert.Equal(t, "prefix: ", filtered.(*Error).Prefix())
+
+	filtered = Append(filtered, errors.New("b"), errors.New("c"), errors.New("d"), errors.New("e"), errors.New("f"))
+	assert.Len(t, Inspect(filtered), 5)
+}
+
+func TestFilter_stacks(t *testing.T) {
+	withCaptureStacks(t)
+
+	err := Append(nil, errors.New("a"), io.EOF)
+	err = Append(err, errors.New("b"))
+
+	filtered := Filter(err, func(e error) bool { return e != io.EOF })
+	mErr := filtered.(*Error)
+	assert.Equal(t, err.(*Error).StackTrace(0), mErr.StackTrace(0))
+	assert.Equal(t, err.(*Error).StackTrace(2), mErr.StackTrace(1))
+}
+
+func TestMap(t *testing.T) {
+	DefaultFormatter = ListFormatterFunc
+	err := treeForWalk()
+
+	mapped := Map(err, func(e error) error {
+		if e == io.EOF {
+			return nil
+		}
+		return fmt.Errorf("mapped %w", e)
+	})
+	assert.Equal(t, "3 errors occurred:\n"+
+		"  - mapped a\n"+
+		"  - nested:\n"+
+		"      - mapped b1\n"+
+		"  - mapped c", mapped.Error())
+
+	assert.Nil(t, Map(err, func(error) error { return nil }))
+	assert.Equal(t, "mapped EOF", Map(io.EOF, func(e error) error { return fmt.Errorf("mapped %w", e) }).Error())
+}
+
+func TestMap_cycle(t *testing.T) {
+	DefaultFormatter = ListFormatterFunc
+	a, _ := manualCycle()
+
+	mapped := Map(a, func(e error) error {
+		if _, ok := e.(*FieldError); ok {
+			return nil
+		}
+		return e
+	})
+	assert.Equal(t, "3 errors occurred:\n"+
+		"  - a1\n"+
+		"  - EOF\n"+
+		"  - 1 error occurred:\n"+
+		"      - b1", mapped.Error())
+}
+
+func TestPartition(t *testing.T) {
+	DefaultFormatter = ListFormatterFunc
+	err := treeForWalk()
+
+	calls := 0
+	matched, rest := Partition(err, func(e error) bool {
+		calls++
+		return strings.HasPrefix(e.Error(), "b") || e == io.EOF
+	})
+	assert.Equal(t, 4, calls)
+	assert.Equal(t, "1 error occurred:\n"+
+		"  - nested:\n"+
+		"      - b1\n"+
+		"      - EOF", matched.Error())
+	assert.Equal(t, "2 errors occurred:\n"+
+		"  - a\n"+
+		"  - c", rest.Error())
+
+	matched, rest = Partition(err, func(error) bool { return true })
+	assert.Equal(t, err.Error(), matched.Error())
+	assert.Nil(t, rest)
+
+	matched, rest = Partition(nil, func(error) bool { return true })
+	assert.Nil(t, matched)
+	assert.Nil(t, rest)
+}
+
+func TestPartition_bounded(t *testing.T) {
+	DefaultFormatter = ListFormatterFunc
+	err := Merge(Bounded(&Error{}, 2, KeepFirst), manyErrors(10))
+
+	matched, rest := Partition(err, func(e error) bool {
+		return e.Error() == "err 0"
+	})
+	assert.Equal(t, "1 error occurred:\n"+
+		"  - err 0", matched.Error())
+	assert.Equal(t, 1, matched.(*Error).Len())
+	assert.Equal(t, 1, rest.(*Error).Len())
+	assert.Equal(t, 8, err.(*Error).Dropped())
+
+	// the capacity is preserved
+	rest = Append(rest, errors.New("a"), errors.New("b"))
+	assert.Equal(t, 1, rest.(*Error).Dropped())
+}